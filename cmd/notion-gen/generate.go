@@ -0,0 +1,330 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ketion-so/go-notion/notion"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// config is the configuration of the generated code.
+type config struct {
+	Package  string
+	TypeName string
+}
+
+// column is a property of the database mapped to a field of the row struct.
+type column struct {
+	name       string
+	field      string
+	goType     string
+	typ        object.PropertyType
+	options    []option
+	optType    string
+	filterFunc string
+	matchFunc  string
+	skipNote   string
+}
+
+type option struct {
+	name  string
+	ident string
+}
+
+// filterBuilders maps the property types to the constructors of the filter
+// package.
+var filterBuilders = map[object.PropertyType]string{
+	object.TitlePropertyType:       "filter.Title(%q)",
+	object.TextPropertyType:        "filter.Text(%q)",
+	object.NumberPropertyType:      "filter.Number(%q)",
+	object.SelectPropertyType:      "filter.Select(%q)",
+	object.MultiSelectPropertyType: "filter.MultiSelect(%q)",
+	object.DatePropertyType:        "filter.Date(%q)",
+	object.PeoplePropertyType:      "filter.People(%q)",
+	object.FilesPropertyType:       "filter.Files(%q)",
+	object.CheckboxPropertyType:    "filter.Checkbox(%q)",
+	object.URLPropertyType:         "filter.URL(%q)",
+	object.EmailPropertyType:       "filter.Email(%q)",
+	object.PhoneNumberPropertyType: "filter.PhoneNumber(%q)",
+	object.RelationPropertyType:    "filter.Relation(%q)",
+	object.FormulaPropertyType:     "filter.Formula(%q)",
+	object.RollupPropertyType:      "filter.Rollup(%q)",
+
+	object.CreatedTimePropertyType:    "filter.CreatedTime()",
+	object.LastEditedTimePropertyType: "filter.LastEditedTime()",
+}
+
+// filterTypes maps the property types to the condition types returned by
+// their filter constructors.
+var filterTypes = map[object.PropertyType]string{
+	object.TitlePropertyType:       "*filter.TextCondition",
+	object.TextPropertyType:        "*filter.TextCondition",
+	object.NumberPropertyType:      "*filter.NumberCondition",
+	object.SelectPropertyType:      "*filter.SelectCondition",
+	object.MultiSelectPropertyType: "*filter.MultiSelectCondition",
+	object.DatePropertyType:        "*filter.DateCondition",
+	object.PeoplePropertyType:      "*filter.ListCondition",
+	object.FilesPropertyType:       "*filter.FilesCondition",
+	object.CheckboxPropertyType:    "*filter.CheckboxCondition",
+	object.URLPropertyType:         "*filter.TextCondition",
+	object.EmailPropertyType:       "*filter.TextCondition",
+	object.PhoneNumberPropertyType: "*filter.TextCondition",
+	object.RelationPropertyType:    "*filter.ListCondition",
+	object.FormulaPropertyType:     "*filter.FormulaCondition",
+	object.RollupPropertyType:      "*filter.RollupCondition",
+
+	object.CreatedTimePropertyType:    "*filter.DateCondition",
+	object.LastEditedTimePropertyType: "*filter.DateCondition",
+}
+
+// generate returns the Go source of the row struct, the option constants and
+// the filter helpers of the database.
+func generate(db *notion.Database, cfg *config) ([]byte, error) {
+	typeName := cfg.TypeName
+	if typeName == "" {
+		typeName = identifier(plainText(db.Title))
+	}
+	if typeName == "" {
+		return nil, fmt.Errorf("database %s has no title, the type name must be set", db.ID)
+	}
+
+	columns := columnsOf(db, typeName)
+
+	usesTime := false
+	for _, c := range columns {
+		if strings.Contains(c.goType, "time.Time") {
+			usesTime = true
+		}
+	}
+
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by notion-gen from database %s. DO NOT EDIT.\n\n", db.ID)
+	fmt.Fprintf(&b, "package %s\n\n", cfg.Package)
+	b.WriteString("import (\n")
+	if usesTime {
+		b.WriteString("\t\"time\"\n\n")
+	}
+	b.WriteString("\t\"github.com/ketion-so/go-notion/notion\"\n")
+	b.WriteString("\t\"github.com/ketion-so/go-notion/notion/filter\"\n")
+	b.WriteString(")\n\n")
+
+	fmt.Fprintf(&b, "// %sDatabaseID is the ID of the database of %s rows.\n", typeName, typeName)
+	fmt.Fprintf(&b, "const %sDatabaseID = %q\n\n", typeName, db.ID)
+
+	if title := plainText(db.Title); title != "" {
+		fmt.Fprintf(&b, "// %s is a row of the %q database.\n", typeName, title)
+	} else {
+		fmt.Fprintf(&b, "// %s is a row of the database.\n", typeName)
+	}
+	fmt.Fprintf(&b, "type %s struct {\n", typeName)
+	b.WriteString("\tnotion.RowSnapshot\n\n")
+	b.WriteString("\tID string `notion:\",id\"`\n")
+	for _, c := range columns {
+		if c.skipNote != "" {
+			fmt.Fprintf(&b, "\t// %s\n", c.skipNote)
+			continue
+		}
+		fmt.Fprintf(&b, "\t%s %s `notion:%q`\n", c.field, c.goType, c.name+","+string(c.typ))
+	}
+	b.WriteString("}\n")
+
+	for _, c := range columns {
+		if c.optType == "" {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n// %s is an option of the %q property.\n", c.optType, c.name)
+		fmt.Fprintf(&b, "type %s string\n", c.optType)
+		if len(c.options) == 0 {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n// Options of the %q property.\n", c.name)
+		b.WriteString("const (\n")
+		for _, o := range c.options {
+			fmt.Fprintf(&b, "\t%s %s = %q\n", o.ident, c.optType, o.name)
+		}
+		b.WriteString(")\n")
+	}
+
+	for _, c := range columns {
+		builder, ok := filterBuilders[c.typ]
+		if !ok {
+			continue
+		}
+
+		fmt.Fprintf(&b, "\n// %s filters the rows on the %q property.\n", c.filterFunc, c.name)
+		fmt.Fprintf(&b, "func %s() %s {\n", c.filterFunc, filterTypes[c.typ])
+		if strings.Contains(builder, "%q") {
+			builder = fmt.Sprintf(builder, c.name)
+		}
+		fmt.Fprintf(&b, "\treturn %s\n}\n", builder)
+
+		switch c.typ {
+		case object.SelectPropertyType:
+			fmt.Fprintf(&b, "\n// %s matches the rows whose %q option is o.\n", c.matchFunc, c.name)
+			fmt.Fprintf(&b, "func %s(o %s) filter.Filter {\n", c.matchFunc, c.optType)
+			fmt.Fprintf(&b, "\treturn %s().Equals(string(o))\n}\n", c.filterFunc)
+		case object.MultiSelectPropertyType:
+			fmt.Fprintf(&b, "\n// %s matches the rows whose %q options contain o.\n", c.matchFunc, c.name)
+			fmt.Fprintf(&b, "func %s(o %s) filter.Filter {\n", c.matchFunc, c.optType)
+			fmt.Fprintf(&b, "\treturn %s().Contains(string(o))\n}\n", c.filterFunc)
+		}
+	}
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated code is invalid: %w\n%s", err, b.Bytes())
+	}
+
+	return src, nil
+}
+
+// columnsOf returns the properties of the database, sorted by name, with
+// unique field names and unique names for the declarations of the file.
+func columnsOf(db *notion.Database, typeName string) []*column {
+	names := make([]string, 0, len(db.Properties))
+	for name := range db.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	used := map[string]bool{"ID": true, "RowSnapshot": true}
+	columns := []*column{}
+	for _, name := range names {
+		p := db.Properties[name]
+		c := &column{name: name, typ: p.GetType(), field: unique(identifier(name), used)}
+
+		switch p := p.(type) {
+		case *notion.TitlePropertyConfig, *notion.TextPropertyConfig, *notion.URLPropertyConfig,
+			*notion.EmailPropertyConfig, *notion.PhoneNumberPropertyConfig:
+			c.goType = "string"
+		case *notion.NumberPropertyConfig:
+			c.goType = "*float64"
+		case *notion.CheckboxPropertyConfig:
+			c.goType = "bool"
+		case *notion.DatePropertyConfig:
+			c.goType = "*time.Time"
+		case *notion.CreatedTimePropertyConfig, *notion.LastEditedTimePropertyConfig:
+			c.goType = "time.Time"
+		case *notion.PeoplePropertyConfig, *notion.RelationPropertyConfig:
+			c.goType = "[]string"
+		case *notion.FilesPropertyConfig:
+			c.goType = "[]notion.File"
+		case *notion.CreatedByPropertyConfig, *notion.LastEditedByPropertyConfig:
+			c.goType = "string"
+		case *notion.SelectPropertyConfig:
+			c.optType = typeName + c.field
+			for _, o := range p.Options {
+				c.options = append(c.options, option{name: o.Name})
+			}
+		case *notion.MultiSelectPropertyConfig:
+			c.optType = typeName + c.field
+			for _, o := range p.Options {
+				c.options = append(c.options, option{name: o.Name})
+			}
+		default:
+			c.skipNote = fmt.Sprintf("%s (%s) is not mapped: its value type is not part of the schema.", name, c.typ)
+		}
+
+		columns = append(columns, c)
+	}
+
+	// The declarations of the file share a single namespace: the helpers are
+	// named before the options, which get a suffix when they collide.
+	decls := map[string]bool{typeName: true, typeName + "DatabaseID": true}
+	for _, c := range columns {
+		if c.optType != "" {
+			c.optType = unique(typeName+c.field, decls)
+			if c.typ == object.MultiSelectPropertyType {
+				c.goType = "[]" + c.optType
+			} else {
+				c.goType = c.optType
+			}
+		}
+	}
+	for _, c := range columns {
+		if _, ok := filterBuilders[c.typ]; ok {
+			c.filterFunc = unique(typeName+c.field+"Filter", decls)
+		}
+		switch c.typ {
+		case object.SelectPropertyType:
+			c.matchFunc = unique(typeName+c.field+"Is", decls)
+		case object.MultiSelectPropertyType:
+			c.matchFunc = unique(typeName+c.field+"Contains", decls)
+		}
+	}
+	for _, c := range columns {
+		for i := range c.options {
+			ident := identifier(c.options[i].name)
+			if ident == "" {
+				ident = "Option"
+			}
+			c.options[i].ident = unique(c.optType+ident, decls)
+		}
+	}
+
+	return columns
+}
+
+// identifier turns a name into an exported Go identifier, e.g. "Food group"
+// into FoodGroup. Characters which are not letters nor digits are dropped,
+// apostrophes without starting a new word.
+func identifier(name string) string {
+	var b strings.Builder
+	upper := true
+	for _, r := range name {
+		if r == '\'' || r == '’' {
+			continue
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			upper = true
+			continue
+		}
+		if upper {
+			r = unicode.ToUpper(r)
+			upper = false
+		}
+		b.WriteRune(r)
+	}
+
+	s := b.String()
+	if s != "" && !unicode.IsLetter([]rune(s)[0]) {
+		s = "P" + s
+	}
+	return s
+}
+
+// unique returns the identifier, suffixed with a number when it is already
+// used, and marks it as used.
+func unique(ident string, used map[string]bool) string {
+	if ident == "" {
+		ident = "Property"
+	}
+
+	s := ident
+	for i := 2; used[s]; i++ {
+		s = fmt.Sprintf("%s%d", ident, i)
+	}
+	used[s] = true
+
+	return s
+}
+
+func plainText(texts []notion.TextObject) string {
+	var b strings.Builder
+	for _, t := range texts {
+		switch {
+		case t.PlainText != "":
+			b.WriteString(t.PlainText)
+		case t.Text != nil:
+			b.WriteString(t.Text.Content)
+		}
+	}
+	return b.String()
+}
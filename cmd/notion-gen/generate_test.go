@@ -0,0 +1,218 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion"
+)
+
+func readSchema(t *testing.T) *notion.Database {
+	t.Helper()
+
+	b, err := ioutil.ReadFile("testdata/grocery_list.json")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	db := &notion.Database{}
+	if err := json.Unmarshal(b, db); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	return db
+}
+
+func TestGenerate(t *testing.T) {
+	got, err := generate(readSchema(t), &config{Package: "groceries"})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want, err := ioutil.ReadFile("testdata/grocery_list.golden")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(string(got), string(want)); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if err := typeCheck("groceries", got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+}
+
+func TestGenerate_typeName(t *testing.T) {
+	db := readSchema(t)
+	db.Title = nil
+
+	if _, err := generate(db, &config{Package: "groceries"}); err == nil {
+		t.Fatalf("expected error for database without title")
+	}
+
+	got, err := generate(db, &config{Package: "groceries", TypeName: "Grocery"})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	f, err := parser.ParseFile(token.NewFileSet(), "grocery.go", got, 0)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if f.Scope.Lookup("Grocery") == nil || f.Scope.Lookup("GroceryFoodGroupFruit") == nil {
+		t.Fatalf("expected Grocery declarations got:\n%s", got)
+	}
+}
+
+func TestGenerate_collisions(t *testing.T) {
+	db := &notion.Database{
+		ID: "668d797c-76fa-4934-9b05-ad288df2d136",
+		Properties: map[string]notion.PropertyConfig{
+			"Name": &notion.TitlePropertyConfig{},
+			"Status": &notion.SelectPropertyConfig{Options: []notion.SelectOption{
+				{Name: "Filter"}, {Name: "Is"}, {Name: "Done"}, {Name: "done"},
+			}},
+			"Status filter": &notion.CheckboxPropertyConfig{},
+			"Tags": &notion.MultiSelectPropertyConfig{Options: []notion.MultiSelectOption{
+				{Name: "Contains"},
+			}},
+			"Database ID": &notion.TextPropertyConfig{},
+		},
+	}
+
+	got, err := generate(db, &config{Package: "tasks", TypeName: "Task"})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if err := typeCheck("tasks", got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	f, err := parser.ParseFile(token.NewFileSet(), "task.go", got, 0)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	for _, name := range []string{
+		"TaskStatusFilter", "TaskStatusIs", "TaskStatusFilter2", "TaskStatusIs2", "TaskStatusDone", "TaskStatusDone2",
+		"TaskStatusFilterFilter", "TaskTagsContains", "TaskTagsContains2", "TaskDatabaseID", "TaskDatabaseIDFilter",
+	} {
+		if f.Scope.Lookup(name) == nil {
+			t.Errorf("%s is not declared", name)
+		}
+	}
+}
+
+func TestTypeCheck(t *testing.T) {
+	src := []byte("package tasks\n\nconst A = 1\n\nconst A = 2\n")
+	if err := typeCheck("tasks", src); err == nil {
+		t.Fatalf("expected error for duplicate declarations")
+	}
+}
+
+func TestIdentifier(t *testing.T) {
+	tcs := map[string]struct {
+		name string
+		want string
+	}{
+		"words":      {"Food group", "FoodGroup"},
+		"apostrophe": {"Gus's Community Market", "GussCommunityMarket"},
+		"emoji":      {"🥦Vegetable", "Vegetable"},
+		"digit":      {"+1", "P1"},
+		"symbols":    {"!!", ""},
+		"unicode":    {"état", "État"},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			if got := identifier(tc.name); got != tc.want {
+				t.Fatalf("identifier(%q) got:%s want:%s", tc.name, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestUnique(t *testing.T) {
+	used := map[string]bool{}
+
+	got := []string{unique("Name", used), unique("Name", used), unique("", used), unique("Name", used)}
+	want := []string{"Name", "Name2", "Property", "Name3"}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+// typeCheck parses and type-checks the generated source, e.g. to catch
+// duplicate declarations. The imported packages are read from their export
+// data, built by the go command within the module.
+func typeCheck(pkg string, src []byte) error {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "generated.go", src, 0)
+	if err != nil {
+		return err
+	}
+
+	exports, err := exportData(f.Imports)
+	if err != nil {
+		return err
+	}
+
+	conf := types.Config{Importer: importer.ForCompiler(fset, "gc", func(path string) (io.ReadCloser, error) {
+		file, ok := exports[path]
+		if !ok {
+			return nil, fmt.Errorf("no export data for %s", path)
+		}
+		return os.Open(file)
+	})}
+	_, err = conf.Check(pkg, fset, []*ast.File{f}, nil)
+	return err
+}
+
+// exportData returns the export data files of the imported packages.
+func exportData(imports []*ast.ImportSpec) (map[string]string, error) {
+	args := []string{"list", "-export", "-f", "{{.ImportPath}} {{.Export}}"}
+	for _, spec := range imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, path)
+	}
+
+	var stderr bytes.Buffer
+	cmd := exec.Command("go", args...)
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return nil, fmt.Errorf("go list: %w: %s", err, stderr.Bytes())
+	}
+
+	exports := map[string]string{}
+	for _, line := range strings.Split(strings.TrimSpace(string(out)), "\n") {
+		if i := strings.Index(line, " "); i > 0 {
+			exports[line[:i]] = line[i+1:]
+		}
+	}
+
+	return exports, nil
+}
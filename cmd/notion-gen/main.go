@@ -0,0 +1,93 @@
+// Command notion-gen generates the Go code of a Notion database: a row struct
+// with notion tags, usable with notion.Table, typed constants for the options
+// of the select and multi-select properties, and filter helpers per property.
+//
+// The schema is read from the API, or from a file holding the JSON response
+// of a database retrieval to work offline:
+//
+//	notion-gen -database 668d797c-76fa-4934-9b05-ad288df2d136 -o groceries.go
+//	notion-gen -schema groceries.json -package groceries -type Grocery
+//
+// The access token is read from the NOTION_TOKEN environment variable, or
+// from the -token flag. Regenerating the code after a schema change surfaces
+// the breakage at compile time.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/ketion-so/go-notion/notion"
+)
+
+func main() {
+	if err := run(os.Args[1:], os.Stdout); err != nil {
+		if !errors.Is(err, flag.ErrHelp) {
+			fmt.Fprintf(os.Stderr, "notion-gen: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdout io.Writer) error {
+	fs := flag.NewFlagSet("notion-gen", flag.ContinueOnError)
+	databaseID := fs.String("database", "", "ID of the database to generate the code of")
+	token := fs.String("token", os.Getenv("NOTION_TOKEN"), "access token, defaults to $NOTION_TOKEN")
+	schema := fs.String("schema", "", "JSON file of the database to read instead of the API")
+	pkg := fs.String("package", "main", "package of the generated code")
+	typeName := fs.String("type", "", "name of the row type, defaults to the database title")
+	output := fs.String("o", "", "output file, defaults to the standard output")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+
+	db, err := loadDatabase(*schema, *databaseID, *token)
+	if err != nil {
+		return err
+	}
+
+	src, err := generate(db, &config{Package: *pkg, TypeName: *typeName})
+	if err != nil {
+		return err
+	}
+
+	if *output == "" {
+		_, err = stdout.Write(src)
+		return err
+	}
+
+	return ioutil.WriteFile(*output, src, 0644)
+}
+
+// loadDatabase reads the database from the schema file when set, or else
+// retrieves it from the API.
+func loadDatabase(schema, databaseID, token string) (*notion.Database, error) {
+	if schema != "" {
+		b, err := ioutil.ReadFile(schema)
+		if err != nil {
+			return nil, err
+		}
+
+		db := &notion.Database{}
+		if err := json.Unmarshal(b, db); err != nil {
+			return nil, fmt.Errorf("invalid schema %s: %w", schema, err)
+		}
+
+		return db, nil
+	}
+
+	if databaseID == "" {
+		return nil, errors.New("either -database or -schema must be set")
+	}
+	if token == "" {
+		return nil, errors.New("access token must be set with -token or $NOTION_TOKEN")
+	}
+
+	return notion.NewClient(token).Databases.Get(context.Background(), databaseID)
+}
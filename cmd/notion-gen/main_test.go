@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	want, err := ioutil.ReadFile("testdata/grocery_list.golden")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var stdout bytes.Buffer
+	if err := run([]string{"-schema", "testdata/grocery_list.json", "-package", "groceries"}, &stdout); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if !bytes.Equal(stdout.Bytes(), want) {
+		t.Fatalf("stdout got:\n%s\nwant:\n%s", stdout.Bytes(), want)
+	}
+
+	output := filepath.Join(t.TempDir(), "grocery_list.go")
+	if err := run([]string{"-schema", "testdata/grocery_list.json", "-package", "groceries", "-o", output}, &stdout); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got, err := ioutil.ReadFile(output)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("output got:\n%s\nwant:\n%s", got, want)
+	}
+}
+
+func TestRun_errors(t *testing.T) {
+	tcs := map[string][]string{
+		"no database":    {},
+		"no token":       {"-database", "668d797c", "-token", ""},
+		"missing schema": {"-schema", "testdata/missing.json"},
+		"invalid schema": {"-schema", "testdata/grocery_list.golden"},
+		"unknown flag":   {"-unknown"},
+	}
+
+	for n, args := range tcs {
+		args := args
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			if err := run(args, ioutil.Discard); err == nil {
+				t.Fatalf("expected error")
+			}
+		})
+	}
+}
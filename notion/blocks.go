@@ -23,8 +23,10 @@ type BlocksService service
 //go:generate gomodifytags -file $GOFILE -struct ListBlockChildrenResult -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct ListBlockChildrenResult -add-tags json,mapstructure -w -transform snakecase
 type ListBlockChildrenResult struct {
-	Object  object.Type `json:"object" mapstructure:"object"`
-	Results []Block     `json:"results" mapstructure:"results"`
+	Object     object.Type `json:"object" mapstructure:"object"`
+	Results    []Block     `json:"results" mapstructure:"results"`
+	NextCursor string      `json:"next_cursor" mapstructure:"next_cursor"`
+	HasMore    bool        `json:"has_more" mapstructure:"has_more"`
 }
 
 // Block represents a block.
@@ -223,8 +225,9 @@ func (b *ChildPageBlock) GetType() object.BlockType {
 // ListChildren blocks list.
 //
 // API doc: https://developers.notion.com/reference/get-block-children
-func (s *BlocksService) ListChildren(ctx context.Context, blockID string) (*ListBlockChildrenResult, error) {
-	resp, err := s.client.get(ctx, fmt.Sprintf("%s/%s/children", blocksPath, blockID))
+func (s *BlocksService) ListChildren(ctx context.Context, blockID string, lopts *ListOptions, opts ...RequestOption) (*ListBlockChildrenResult, error) {
+	path := addOptions(fmt.Sprintf("%s/%s/children", blocksPath, blockID), lopts)
+	resp, err := s.client.get(ctx, newOperation("Blocks.ListChildren", blockID), path, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -256,17 +259,22 @@ func (s *BlocksService) ListChildren(ctx context.Context, blockID string) (*List
 		blocks = append(blocks, block)
 	}
 
+	nextCursor, _ := data["next_cursor"].(string)
+	hasMore, _ := data["has_more"].(bool)
+
 	return &ListBlockChildrenResult{
-		Object:  object.Type(data["object"].(string)),
-		Results: blocks,
+		Object:     object.Type(data["object"].(string)),
+		Results:    blocks,
+		NextCursor: nextCursor,
+		HasMore:    hasMore,
 	}, nil
 }
 
 // AppendChildren children block.
 //
 // API doc: https://developers.notion.com/reference/get-block-children
-func (s *BlocksService) AppendChildren(ctx context.Context, blockID string, children Block) (Block, error) {
-	resp, err := s.client.patch(ctx, fmt.Sprintf("%s/%s/children", databasesPath, blockID), children)
+func (s *BlocksService) AppendChildren(ctx context.Context, blockID string, children Block, opts ...RequestOption) (Block, error) {
+	resp, err := s.client.patch(ctx, newOperation("Blocks.AppendChildren", blockID), fmt.Sprintf("%s/%s/children", databasesPath, blockID), children, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -152,7 +152,7 @@ func TestDatabasesService_ListChildren(t *testing.T) {
 				fmt.Fprint(w, getListChildrenJSON())
 			})
 
-			got, err := client.Blocks.ListChildren(context.Background(), tc.id)
+			got, err := client.Blocks.ListChildren(context.Background(), tc.id, nil)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
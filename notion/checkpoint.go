@@ -0,0 +1,123 @@
+package notion
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+const (
+	queryCheckpointKind  = "databases.query"
+	searchCheckpointKind = "search"
+)
+
+// ErrInvalidCheckpoint is returned when resuming from a malformed checkpoint
+// or from the checkpoint of another kind of iterator.
+var ErrInvalidCheckpoint = errors.New("invalid checkpoint")
+
+// checkpoint is the position of an iterator, with the request it iterates
+// over.
+type checkpoint struct {
+	Kind       string          `json:"kind"`
+	DatabaseID string          `json:"database_id,omitempty"`
+	Request    json.RawMessage `json:"request"`
+	Cursor     string          `json:"cursor,omitempty"`
+	Offset     int             `json:"offset,omitempty"`
+	Done       bool            `json:"done,omitempty"`
+}
+
+func newCheckpoint(kind, databaseID string, req interface{}, p *pager) (string, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	cursor, offset, done := p.position()
+	b, err := json.Marshal(&checkpoint{
+		Kind:       kind,
+		DatabaseID: databaseID,
+		Request:    body,
+		Cursor:     cursor,
+		Offset:     offset,
+		Done:       done,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func parseCheckpoint(s, kind string, req interface{}) (*checkpoint, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCheckpoint, err)
+	}
+
+	cp := &checkpoint{}
+	if err := json.Unmarshal(b, cp); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCheckpoint, err)
+	}
+
+	if cp.Kind != kind {
+		return nil, fmt.Errorf("%w: %s checkpoint cannot resume %s", ErrInvalidCheckpoint, cp.Kind, kind)
+	}
+
+	if err := json.Unmarshal(cp.Request, req); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidCheckpoint, err)
+	}
+
+	return cp, nil
+}
+
+// resume moves the pager of a resumed iterator past the end when the
+// checkpoint has been saved once the iteration was over.
+func (cp *checkpoint) resume(p *pager) {
+	if cp.Done {
+		p.started = true
+	}
+}
+
+// Checkpoint returns an opaque string recording the query and the position of
+// the iterator, which ResumeQuery accepts to continue the iteration later,
+// possibly in another process. The next value returned after resuming is the
+// one Next would have returned.
+func (it *QueryIterator) Checkpoint() (string, error) {
+	return newCheckpoint(queryCheckpointKind, it.databaseID, &it.query, it.p)
+}
+
+// ResumeQuery returns an iterator continuing the iteration saved by
+// QueryIterator.Checkpoint. The MaxItems option counts from the checkpoint.
+func (s *DatabasesService) ResumeQuery(checkpoint string, iopts *IteratorOptions, opts ...RequestOption) (*QueryIterator, error) {
+	query := DatabaseQuery{}
+	cp, err := parseCheckpoint(checkpoint, queryCheckpointKind, &query)
+	if err != nil {
+		return nil, err
+	}
+
+	it := s.queryIterator(cp.DatabaseID, query, cp.Cursor, cp.Offset, iopts, opts)
+	cp.resume(it.p)
+	return it, nil
+}
+
+// Checkpoint returns an opaque string recording the search request and the
+// position of the iterator, which Resume accepts to continue the iteration
+// later, possibly in another process.
+func (it *SearchIterator) Checkpoint() (string, error) {
+	return newCheckpoint(searchCheckpointKind, "", &it.req, it.p)
+}
+
+// Resume returns an iterator continuing the iteration saved by
+// SearchIterator.Checkpoint. The MaxItems option counts from the checkpoint.
+func (s *SearchService) Resume(checkpoint string, iopts *IteratorOptions, opts ...RequestOption) (*SearchIterator, error) {
+	req := SearchRequest{}
+	cp, err := parseCheckpoint(checkpoint, searchCheckpointKind, &req)
+	if err != nil {
+		return nil, err
+	}
+
+	it := s.iterator(req, cp.Cursor, cp.Offset, iopts, opts)
+	cp.resume(it.p)
+	return it, nil
+}
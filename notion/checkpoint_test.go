@@ -0,0 +1,148 @@
+package notion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func pageItemsJSON(n int) []string {
+	pages := []string{}
+	for i := 0; i < n; i++ {
+		pages = append(pages, fmt.Sprintf(`{"object":"page","id":"p%d","parent":{"type":"database_id","database_id":"db"},"properties":{}}`, i))
+	}
+	return pages
+}
+
+func TestQueryIterator_Checkpoint(t *testing.T) {
+	tcs := map[string]struct {
+		read       int
+		resumeOpts *IteratorOptions
+		want       []string
+	}{
+		"not started": {
+			0,
+			&IteratorOptions{PageSize: 2},
+			[]string{"p0", "p1", "p2", "p3", "p4"},
+		},
+		"within a page": {
+			3,
+			&IteratorOptions{PageSize: 2},
+			[]string{"p3", "p4"},
+		},
+		"end of a page": {
+			2,
+			nil,
+			[]string{"p2", "p3", "p4"},
+		},
+		"smaller page size": {
+			3,
+			&IteratorOptions{PageSize: 1},
+			[]string{"p3", "p4"},
+		},
+		"max items": {
+			1,
+			&IteratorOptions{MaxItems: 2},
+			[]string{"p1", "p2"},
+		},
+		"done": {
+			5,
+			nil,
+			[]string{},
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			var mu sync.Mutex
+			requests := []string{}
+			mux.HandleFunc(fmt.Sprintf("/%s/db/query", databasesPath), pagedHandler(t, pageItemsJSON(5), &mu, &requests))
+
+			it := client.Databases.QueryIterator("db", &DatabaseQuery{}, &IteratorOptions{PageSize: 3})
+			for i := 0; i < tc.read; i++ {
+				if !it.Next(context.Background()) {
+					t.Fatalf("iterator stopped: %v", it.Err())
+				}
+			}
+
+			cp, err := it.Checkpoint()
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			resumed, err := client.Databases.ResumeQuery(cp, tc.resumeOpts)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			pages, err := resumed.All(context.Background())
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			ids := []string{}
+			for _, p := range pages {
+				ids = append(ids, p.ID)
+			}
+
+			if diff := cmp.Diff(ids, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestSearchIterator_Checkpoint(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	requests := []string{}
+	mux.HandleFunc("/"+searchPath, pagedHandler(t, pageItemsJSON(4), &mu, &requests))
+
+	it := client.Search.Iterator(&SearchRequest{Query: "tasks"}, &IteratorOptions{PageSize: 3})
+	if !it.Next(context.Background()) {
+		t.Fatalf("iterator stopped: %v", it.Err())
+	}
+
+	cp, err := it.Checkpoint()
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	resumed, err := client.Search.Resume(cp, nil)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if resumed.req.Query != "tasks" {
+		t.Fatalf("search request not restored got:%+v", resumed.req)
+	}
+
+	got, err := resumed.All(context.Background())
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if len(got) != 3 || got[0].(*Page).ID != "p1" {
+		t.Fatalf("unexpected results after resuming: %+v", got)
+	}
+
+	if _, err := client.Databases.ResumeQuery(cp, nil); !errors.Is(err, ErrInvalidCheckpoint) {
+		t.Fatalf("expected invalid checkpoint got:%v", err)
+	}
+
+	if _, err := client.Search.Resume("not a checkpoint", nil); !errors.Is(err, ErrInvalidCheckpoint) {
+		t.Fatalf("expected invalid checkpoint got:%v", err)
+	}
+}
@@ -9,17 +9,9 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"sync"
 	"time"
-
-	"github.com/ketion-so/go-notion/notion/object"
-)
-
-var (
-	defaultRateLimit = &RateLimit{
-		Limit:     10000,
-		Remaining: 10000,
-	}
 )
 
 const (
@@ -37,17 +29,28 @@ type service struct {
 
 // Client represents the API client for Notion
 type Client struct {
-	accessKey string
-	common    service
-	client    *http.Client
+	tokenSource TokenSource
+	common      service
+	client      *http.Client
 
 	mu sync.RWMutex
 
+	// RateLimit holds the latest rate limit reported by the API.
+	//
+	// Deprecated: reading it is not safe for concurrent use, use RateLimitSnapshot instead.
 	RateLimit   *RateLimit
 	UserAgent   string
 	AccessToken string
 	BaseURL     *url.URL
 	version     string
+	retryPolicy *RetryPolicy
+	limiter     Limiter
+	middlewares []Middleware
+	logger      Middleware
+	observer    Observer
+	doer        Doer
+
+	rateLimitHook func(RateLimit)
 
 	Blocks    *BlocksService
 	Databases *DatabasesService
@@ -63,6 +66,38 @@ type RateLimit struct {
 	Reset     time.Time
 }
 
+// ListOptions specifies the optional pagination parameters of the list
+// endpoints.
+//
+// API doc: https://developers.notion.com/reference/pagination
+type ListOptions struct {
+	// StartCursor is the cursor returned by the previous page to continue from.
+	StartCursor string
+
+	// PageSize is the number of items of a page, 100 at most.
+	PageSize int32
+}
+
+// addOptions adds the pagination parameters of opts to the path.
+func addOptions(path string, opts *ListOptions) string {
+	if opts == nil {
+		return path
+	}
+
+	q := url.Values{}
+	if opts.StartCursor != "" {
+		q.Set("start_cursor", opts.StartCursor)
+	}
+	if opts.PageSize > 0 {
+		q.Set("page_size", strconv.Itoa(int(opts.PageSize)))
+	}
+	if len(q) == 0 {
+		return path
+	}
+
+	return path + "?" + q.Encode()
+}
+
 // ClientOption represents options to configure this Notion API client.
 type ClientOption func(c *Client)
 
@@ -84,19 +119,26 @@ func WithVersion(version string) ClientOption {
 func NewClient(accessKey string, opts ...ClientOption) *Client {
 	baseURL, _ := url.Parse(baseURL)
 	c := &Client{
-		BaseURL:   baseURL,
-		accessKey: accessKey,
-		UserAgent: defaultUserAgent,
-		version:   defaultVersion,
-		client:    http.DefaultClient,
+		BaseURL:     baseURL,
+		tokenSource: StaticTokenSource(accessKey),
+		UserAgent:   defaultUserAgent,
+		version:     defaultVersion,
+		client:      http.DefaultClient,
+		limiter:     NewLimiter(defaultRequestsPerSecond, defaultBurst),
 	}
 
 	for _, opt := range opts {
 		opt(c)
 	}
 
+	middlewares := c.middlewares
+	if c.logger != nil {
+		// The logger is the innermost middleware to record the calls as sent.
+		middlewares = append(middlewares[:len(middlewares):len(middlewares)], c.logger)
+	}
+	c.doer = chainMiddlewares(middlewares, DoerFunc(c.send))
 	c.common.client = c
-	c.RateLimit = defaultRateLimit
+	c.RateLimit = newDefaultRateLimit()
 
 	c.Blocks = (*BlocksService)(&c.common)
 	c.Databases = (*DatabasesService)(&c.common)
@@ -106,115 +148,187 @@ func NewClient(accessKey string, opts ...ClientOption) *Client {
 	return c
 }
 
-func (c *Client) request(ctx context.Context, method, urlStr string, body interface{}) (*http.Response, error) {
-	u, err := c.BaseURL.Parse(fmt.Sprintf("v1/%s", urlStr))
+func (c *Client) request(ctx context.Context, op operation, method, urlStr string, body interface{}, opts ...RequestOption) (*http.Response, error) {
+	o := newRequestOptions(opts)
+	ctx, cancel := o.withTimeout(ctx)
+
+	ctx, info := c.startCall(ctx, op, method, urlStr)
+	start := time.Now()
+
+	resp, err := c.requestWithRetries(ctx, info, o, method, urlStr, body)
+	c.endCall(ctx, info, start, err)
 	if err != nil {
+		cancel()
 		return nil, err
 	}
 
-	var buf io.ReadWriter
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+func (c *Client) requestWithRetries(ctx context.Context, info *CallInfo, o *requestOptions, method, urlStr string, body interface{}) (*http.Response, error) {
+	// The body is encoded once so that it can be sent again on retries.
+	var buf []byte
 	if body != nil {
-		buf = &bytes.Buffer{}
-		enc := json.NewEncoder(buf)
+		b := &bytes.Buffer{}
+		enc := json.NewEncoder(b)
 		enc.SetEscapeHTML(false)
-		err := enc.Encode(body)
+		if err := enc.Encode(body); err != nil {
+			return nil, err
+		}
+		buf = b.Bytes()
+	}
+
+	for attempt := 0; ; attempt++ {
+		header, err := c.header(ctx, o, buf != nil)
 		if err != nil {
 			return nil, err
 		}
+
+		call := &Call{
+			Method: method,
+			Path:   urlStr,
+			Header: header,
+			Body:   buf,
+		}
+
+		resp, err := c.doer.Do(ctx, call)
+		info.Retries = attempt
+		info.StatusCode = 0
+		if resp != nil {
+			info.StatusCode = resp.StatusCode
+		}
+
+		if err == nil {
+			return resp, nil
+		}
+
+		wait, ok := c.retryPolicy.backoff(ctx, attempt, resp, err)
+		if !ok {
+			return nil, err
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return nil, err
+		}
 	}
+}
 
-	req, err := http.NewRequest(method, u.String(), buf)
+func (c *Client) header(ctx context.Context, o *requestOptions, hasBody bool) (http.Header, error) {
+	token, err := c.tokenSource.Token(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Authorization", fmt.Sprintf("Bearer %s", c.accessKey))
-	req.Header.Add(notionVersionHeader, c.version)
+	h := http.Header{}
+	h.Add("Authorization", fmt.Sprintf("Bearer %s", token))
+	h.Add(notionVersionHeader, c.version)
 
-	if body != nil {
-		req.Header.Set("Content-Type", "application/json")
+	if hasBody {
+		h.Set("Content-Type", "application/json")
 	}
 
 	if c.UserAgent != "" {
-		req.Header.Set("User-Agent", c.UserAgent)
+		h.Set("User-Agent", c.UserAgent)
+	}
+
+	for key, values := range o.header {
+		h[key] = append([]string(nil), values...)
+	}
+
+	if o.version != "" {
+		h.Set(notionVersionHeader, o.version)
+	}
+
+	return h, nil
+}
+
+// send is the innermost Doer of the pipeline, sending the call over HTTP.
+func (c *Client) send(ctx context.Context, call *Call) (*http.Response, error) {
+	u, err := c.BaseURL.Parse(fmt.Sprintf("v1/%s", call.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	var r io.Reader
+	if call.Body != nil {
+		r = bytes.NewReader(call.Body)
+	}
+
+	req, err := http.NewRequest(call.Method, u.String(), r)
+	if err != nil {
+		return nil, err
+	}
+	req.Header = call.Header
+
+	if c.limiter != nil {
+		if err := c.limiter.Wait(ctx); err != nil {
+			return nil, err
+		}
 	}
 
 	return c.do(ctx, req)
 }
 
 // Get requests API GET request.
-func (c *Client) get(ctx context.Context, urlStr string) (*http.Response, error) {
-	return c.request(ctx, "GET", urlStr, nil)
+func (c *Client) get(ctx context.Context, op operation, urlStr string, opts ...RequestOption) (*http.Response, error) {
+	return c.request(ctx, op, "GET", urlStr, nil, opts...)
 }
 
 // Post requests API POST request.
-func (c *Client) post(ctx context.Context, urlStr string, body interface{}) (*http.Response, error) {
-	return c.request(ctx, "POST", urlStr, body)
+func (c *Client) post(ctx context.Context, op operation, urlStr string, body interface{}, opts ...RequestOption) (*http.Response, error) {
+	return c.request(ctx, op, "POST", urlStr, body, opts...)
 }
 
 // Patch requests API Patch request.
-func (c *Client) patch(ctx context.Context, urlStr string, body interface{}) (*http.Response, error) {
-	return c.request(ctx, "PATCH", urlStr, body)
+func (c *Client) patch(ctx context.Context, op operation, urlStr string, body interface{}, opts ...RequestOption) (*http.Response, error) {
+	return c.request(ctx, op, "PATCH", urlStr, body, opts...)
 }
 
-func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
-	resp, err := c.client.Do(req.WithContext(ctx))
+// Do sends a request to an API endpoint which is not modelled by this client,
+// e.g. a newly released one, and decodes the response into out.
+//
+// The path is relative to the API version prefix, e.g. "blocks/<block id>".
+// The body is encoded to JSON unless nil, and out may be any value accepted by
+// json.Unmarshal, such as a *json.RawMessage, or nil to discard the response.
+func (c *Client) Do(ctx context.Context, method, path string, body, out interface{}, opts ...RequestOption) error {
+	path = strings.TrimPrefix(strings.TrimPrefix(path, "/"), "v1/")
+
+	resp, err := c.request(ctx, newOperation("Client.Do", ""), method, path, body, opts...)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	defer resp.Body.Close()
 
-	if l := resp.Header.Get(rateLimitLimitHeader); l != "" {
-		c.mu.Lock()
-		limit, err := strconv.Atoi(l)
-		if err != nil {
-			return nil, err
-		}
-		c.RateLimit.Limit = limit
-		c.mu.Unlock()
+	if out == nil {
+		_, err := io.Copy(io.Discard, resp.Body)
+		return err
 	}
 
-	if r := resp.Header.Get(rateLimitRemainingHeader); r != "" {
-		c.mu.Lock()
-		remaining, err := strconv.Atoi(r)
-		if err != nil {
-			return nil, err
-		}
-		c.RateLimit.Remaining = remaining
-		c.mu.Unlock()
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil && err != io.EOF {
+		return err
 	}
 
-	if r := resp.Header.Get(rateLimitResetHeader); r != "" {
-		c.mu.Lock()
-		r, err := strconv.Atoi(r)
-		if err != nil {
-			return nil, err
-		}
-		c.RateLimit.Reset = time.Unix(int64(r), 0)
-		c.mu.Unlock()
+	return nil
+}
+
+// do sends the request. On API errors the response is returned alongside the
+// *Error with its body already consumed, so that its headers can be inspected.
+func (c *Client) do(ctx context.Context, req *http.Request) (*http.Response, error) {
+	resp, err := c.client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := c.updateRateLimit(resp.Header); err != nil {
+		resp.Body.Close()
+		return nil, err
 	}
 
 	if resp.StatusCode != http.StatusOK {
-		apiErr := &Error{}
-		if err := json.NewDecoder(resp.Body).Decode(apiErr); err != nil {
-			return nil, err
-		}
-		return nil, apiErr
+		defer resp.Body.Close()
+		return resp, newError(resp)
 	}
 
 	return resp, nil
 }
-
-// Error represents error response from Notion
-//go:generate gomodifytags -file $GOFILE -struct Error -clear-tags -w
-//go:generate gomodifytags --file $GOFILE --struct Error -add-tags json,mapstructure -w -transform snakecase
-type Error struct {
-	Object  object.Type      `json:"object" mapstructure:"object"`
-	Status  int              `json:"status" mapstructure:"status"`
-	Code    object.ErrorCode `json:"code" mapstructure:"code"`
-	Message string           `json:"message" mapstructure:"message"`
-}
-
-// Error implements the error interface
-func (e *Error) Error() string {
-	return e.Message
-}
@@ -3,6 +3,7 @@ package notion
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
@@ -11,6 +12,7 @@ import (
 	"time"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/ketion-so/go-notion/notion/object"
 )
 
@@ -37,13 +39,13 @@ func addHeader(w http.ResponseWriter) {
 	w.Header().Add(rateLimitResetHeader, "1598795193")
 }
 
-func setup() (*Client, *http.ServeMux, string, func()) {
+func setup(opts ...ClientOption) (*Client, *http.ServeMux, string, func()) {
 	mux := http.NewServeMux()
 
 	apiHandler := http.NewServeMux()
 	apiHandler.Handle(baseURLPath+"/", http.StripPrefix(baseURLPath, mux))
 	server := httptest.NewServer(apiHandler)
-	client := NewClient(testAccessKey)
+	client := NewClient(testAccessKey, append([]ClientOption{WithLimiter(nil)}, opts...)...)
 	url, _ := url.Parse(server.URL + baseURLPath)
 	client.BaseURL = url
 	return client, mux, server.URL, server.Close
@@ -182,7 +184,7 @@ func TestClient_Do(t *testing.T) {
 				w.Write([]byte("{}"))
 			})
 
-			_, err := client.Users.List(context.Background())
+			_, err := client.Users.List(context.Background(), nil)
 			if err != nil {
 				if tc.shouldPass {
 					t.Fatalf("failed: %v", err)
@@ -191,16 +193,17 @@ func TestClient_Do(t *testing.T) {
 				return
 			}
 
-			if fmt.Sprint(client.RateLimit.Limit) != tc.rateLimiLimitStr {
-				t.Fatalf("rate limit has not been configured got:%d, want:%s", client.RateLimit.Limit, tc.rateLimiLimitStr)
+			rl := client.RateLimitSnapshot()
+			if fmt.Sprint(rl.Limit) != tc.rateLimiLimitStr {
+				t.Fatalf("rate limit has not been configured got:%d, want:%s", rl.Limit, tc.rateLimiLimitStr)
 			}
 
-			if fmt.Sprint(client.RateLimit.Remaining) != tc.rateLimitRemainingStr {
-				t.Fatalf("rate remaning has not been configured got:%d, want:%s", client.RateLimit.Remaining, tc.rateLimitRemainingStr)
+			if fmt.Sprint(rl.Remaining) != tc.rateLimitRemainingStr {
+				t.Fatalf("rate remaning has not been configured got:%d, want:%s", rl.Remaining, tc.rateLimitRemainingStr)
 			}
 
-			if fmt.Sprint(client.RateLimit.Reset.Unix()) != tc.rateLimitResetStr {
-				t.Fatalf("rate reset timestamp has not been configured got:%d, want:%s", client.RateLimit.Reset.Unix(), tc.rateLimitResetStr)
+			if fmt.Sprint(rl.Reset.Unix()) != tc.rateLimitResetStr {
+				t.Fatalf("rate reset timestamp has not been configured got:%d, want:%s", rl.Reset.Unix(), tc.rateLimitResetStr)
 			}
 		})
 	}
@@ -208,23 +211,44 @@ func TestClient_Do(t *testing.T) {
 
 func TestClient_Do_Error(t *testing.T) {
 	type testCase struct {
-		want       interface{}
-		shouldPass bool
+		status int
+		body   string
+		want   *Error
 	}
 
 	tcs := map[string]testCase{
 		"ok": {
+			http.StatusInternalServerError,
+			`{"object": "error", "status": 500, "code": "internal_server_error", "message": "internal server error", "request_id": "req"}`,
 			&Error{
-				object.Error,
-				http.StatusInternalServerError,
-				object.ErrInternalServer,
-				"internal server error",
+				Object:     object.Error,
+				Status:     http.StatusInternalServerError,
+				Code:       object.ErrInternalServer,
+				Message:    "internal server error",
+				RequestID:  "req",
+				HTTPStatus: http.StatusInternalServerError,
 			},
-			true,
 		},
 		"invalid json": {
+			http.StatusBadGateway,
 			invalidJSON,
-			false,
+			&Error{
+				Object:     object.Error,
+				Status:     http.StatusBadGateway,
+				Message:    invalidJSON,
+				HTTPStatus: http.StatusBadGateway,
+			},
+		},
+		"empty body": {
+			http.StatusTooManyRequests,
+			"",
+			&Error{
+				Object:     object.Error,
+				Status:     http.StatusTooManyRequests,
+				Code:       object.ErrRateLimited,
+				Message:    http.StatusText(http.StatusTooManyRequests),
+				HTTPStatus: http.StatusTooManyRequests,
+			},
 		},
 	}
 
@@ -241,21 +265,119 @@ func TestClient_Do_Error(t *testing.T) {
 					t.Fatalf("no notion version header to request")
 				}
 
-				w.WriteHeader(http.StatusInternalServerError)
-				_ = json.NewEncoder(w).Encode(tc.want)
+				w.WriteHeader(tc.status)
+				fmt.Fprint(w, tc.body)
 			})
 
-			_, err := client.Users.List(context.Background())
-			switch v := err.(type) {
-			case *Error:
-				if diff := cmp.Diff(v, tc.want); diff != "" {
-					t.Fatalf("Diff: %s(-got +want)", diff)
+			_, err := client.Users.List(context.Background(), nil)
+			v, ok := err.(*Error)
+			if !ok {
+				t.Fatalf("failed: %v", err)
+			}
+
+			if diff := cmp.Diff(v, tc.want, cmpopts.IgnoreFields(Error{}, "Body")); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if string(v.Body) != tc.body {
+				t.Fatalf("raw body not kept got:%s want:%s", v.Body, tc.body)
+			}
+		})
+	}
+}
+
+func TestClient_Do_raw(t *testing.T) {
+	type comment struct {
+		Object string `json:"object"`
+		ID     string `json:"id"`
+	}
+
+	tcs := map[string]struct {
+		method   string
+		path     string
+		body     interface{}
+		respBody string
+		out      interface{}
+		want     interface{}
+	}{
+		"typed struct": {
+			http.MethodPost,
+			"comments",
+			map[string]string{"discussion_id": "d"},
+			`{"object":"comment","id":"c"}`,
+			&comment{},
+			&comment{Object: "comment", ID: "c"},
+		},
+		"raw message": {
+			http.MethodGet,
+			"/v1/comments",
+			nil,
+			`{"object":"list"}`,
+			&json.RawMessage{},
+			func() *json.RawMessage { m := json.RawMessage(`{"object":"list"}`); return &m }(),
+		},
+		"delete": {
+			http.MethodDelete,
+			"/comments",
+			nil,
+			"",
+			nil,
+			nil,
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc("/comments", func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != tc.method {
+					t.Fatalf("method got:%s want:%s", r.Method, tc.method)
 				}
-			default:
-				if tc.shouldPass {
-					t.Fatalf("failed: %v", v)
+
+				if got := r.Header.Get("Authorization"); got != "Bearer "+testAccessKey {
+					t.Fatalf("no authorization header got:%s", got)
+				}
+
+				if r.Header.Get(notionVersionHeader) == "" {
+					t.Fatalf("no notion version header to request")
 				}
+
+				addHeader(w)
+				fmt.Fprint(w, tc.respBody)
+			})
+
+			if err := client.Do(context.Background(), tc.method, tc.path, tc.body, tc.out); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(tc.out, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if got := client.RateLimitSnapshot().Remaining; got != 99 {
+				t.Fatalf("rate limit not parsed got:%d", got)
 			}
 		})
 	}
 }
+
+func TestClient_Do_rawError(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/comments", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"object":"error","status":404,"code":"object_not_found","message":"not found"}`)
+	})
+
+	var out json.RawMessage
+	err := client.Do(context.Background(), http.MethodGet, "comments", nil, &out)
+	if !errors.Is(err, ErrObjectNotFound) {
+		t.Fatalf("expected not found got:%v", err)
+	}
+}
@@ -2,6 +2,8 @@ package notion
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 	"testing"
@@ -81,7 +83,7 @@ func getDatabaseSON() string {
 			"id": "p:sC",
 			"type": "formula",
 			"formula": {
-			  "value": "if(prop(\"In stock\"), 0, prop(\"Price\"))"
+			  "expression": "if(prop(\"In stock\"), 0, prop(\"Price\"))"
 			}
 		  },
 		  "Last ordered": {
@@ -104,7 +106,6 @@ func getDatabaseSON() string {
 			"type": "multi_select",
 			"multi_select": {
 			  "options": [
-				[
 				  {
 					"id": "d209b920-212c-4040-9d4a-bdf349dd8b2a",
 					"name": "Duc Loi Market",
@@ -125,7 +126,6 @@ func getDatabaseSON() string {
 					"name": "Gus's Community Market",
 					"color": "yellow"
 				  }
-				]
 			  ]
 			}
 		  },
@@ -148,8 +148,11 @@ func TestDatabasesService_Get(t *testing.T) {
 	defer teardown()
 
 	tcs := map[string]struct {
-		id   string
-		want *Database
+		id            string
+		want          *Database
+		wantFoodGroup PropertyConfig
+		wantPrice     PropertyConfig
+		wantMeals     PropertyConfig
 	}{
 		"ok": {
 			"668d797c-76fa-4934-9b05-ad288df2d136",
@@ -167,6 +170,25 @@ func TestDatabasesService_Get(t *testing.T) {
 					},
 				},
 			},
+			&SelectPropertyConfig{
+				Type: object.SelectPropertyType,
+				ID:   "TJmr",
+				Options: []SelectOption{
+					{ID: "96eb622f-4b88-4283-919d-ece2fbed3841", Name: "🥦Vegetable", Color: GreenColor},
+					{ID: "bb443819-81dc-46fb-882d-ebee6e22c432", Name: "🍎Fruit", Color: RedColor},
+					{ID: "7da9d1b9-8685-472e-9da3-3af57bdb221e", Name: "💪Protein", Color: YellowColor},
+				},
+			},
+			&NumberPropertyConfig{Type: object.NumberPropertyType, ID: "cU^N", Format: "dollar"},
+			&RollupPropertyConfig{
+				Type:                 object.RollupPropertyType,
+				ID:                   "Z\\Eh",
+				RelationPropertyName: "Meals",
+				RelationPropertyID:   "mxp^",
+				RollupPropertyName:   "Name",
+				RollupPropertyID:     "title",
+				Function:             "count",
+			},
 		},
 	}
 
@@ -188,6 +210,18 @@ func TestDatabasesService_Get(t *testing.T) {
 			if diff := cmp.Diff(got, tc.want, cmpopts.IgnoreFields(*got, "Properties")); diff != "" {
 				t.Fatalf("Diff: %s(-got +want)", diff)
 			}
+
+			if diff := cmp.Diff(got.Properties["Food group"], tc.wantFoodGroup); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if diff := cmp.Diff(got.Properties["Price"], tc.wantPrice); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if diff := cmp.Diff(got.Properties["Number of meals"], tc.wantMeals); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
 		})
 	}
 }
@@ -386,7 +420,7 @@ func TestDatabasesService_List(t *testing.T) {
 				fmt.Fprint(w, getListDatabaseJSON())
 			})
 
-			got, err := client.Databases.List(context.Background())
+			got, err := client.Databases.List(context.Background(), nil)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -397,3 +431,391 @@ func TestDatabasesService_List(t *testing.T) {
 		})
 	}
 }
+
+func TestDatabasesService_Create(t *testing.T) {
+	tcs := map[string]struct {
+		req      *CreateDatabaseRequest
+		wantBody string
+		wantErr  bool
+	}{
+		"ok": {
+			&CreateDatabaseRequest{
+				Parent: &PageParent{PageID: "98ad959b-2b6a-4774-80ee-00246fb0ea9b"},
+				Title: []TextObject{
+					{Type: TextRichTextType, Text: &Text{Content: "Grocery List"}},
+				},
+				Properties: map[string]PropertyConfig{
+					"Name":     &TitlePropertyConfig{},
+					"In stock": &CheckboxPropertyConfig{},
+					"Food group": &SelectPropertyConfig{
+						Options: []SelectOption{
+							{Name: "🥦Vegetable", Color: GreenColor},
+						},
+					},
+					"Tags":              &MultiSelectPropertyConfig{},
+					"Price":             &NumberPropertyConfig{Format: "dollar"},
+					"Cost of next trip": &FormulaPropertyConfig{Expression: `if(prop("In stock"), 0, prop("Price"))`},
+					"Meals":             &RelationPropertyConfig{DatabaseID: "668d797c-76fa-4934-9b05-ad288df2d136"},
+					"Number of meals":   &RollupPropertyConfig{RelationPropertyName: "Meals", RollupPropertyName: "Name", Function: "count"},
+				},
+			},
+			`{
+				"parent": {"type": "page_id", "page_id": "98ad959b-2b6a-4774-80ee-00246fb0ea9b"},
+				"title": [{"type": "text", "text": {"content": "Grocery List"}}],
+				"properties": {
+					"Name": {"title": {}},
+					"In stock": {"checkbox": {}},
+					"Food group": {"select": {"options": [{"name": "🥦Vegetable", "color": "green"}]}},
+					"Tags": {"multi_select": {"options": []}},
+					"Price": {"number": {"format": "dollar"}},
+					"Cost of next trip": {"formula": {"expression": "if(prop(\"In stock\"), 0, prop(\"Price\"))"}},
+					"Meals": {"relation": {"database_id": "668d797c-76fa-4934-9b05-ad288df2d136"}},
+					"Number of meals": {"rollup": {"relation_property_name": "Meals", "rollup_property_name": "Name", "function": "count"}}
+				}
+			}`,
+			false,
+		},
+		"nil request": {
+			nil,
+			"",
+			true,
+		},
+		"no parent": {
+			&CreateDatabaseRequest{},
+			"",
+			true,
+		},
+		"relation without database": {
+			&CreateDatabaseRequest{
+				Parent:     &PageParent{PageID: "98ad959b-2b6a-4774-80ee-00246fb0ea9b"},
+				Properties: map[string]PropertyConfig{"Name": &TitlePropertyConfig{}, "Meals": &RelationPropertyConfig{}},
+			},
+			"",
+			true,
+		},
+		"no title": {
+			&CreateDatabaseRequest{
+				Parent:     &PageParent{PageID: "98ad959b-2b6a-4774-80ee-00246fb0ea9b"},
+				Properties: map[string]PropertyConfig{"In stock": &CheckboxPropertyConfig{}},
+			},
+			"",
+			true,
+		},
+		"two titles": {
+			&CreateDatabaseRequest{
+				Parent:     &PageParent{PageID: "98ad959b-2b6a-4774-80ee-00246fb0ea9b"},
+				Properties: map[string]PropertyConfig{"Name": &TitlePropertyConfig{}, "Title": &TitlePropertyConfig{}},
+			},
+			"",
+			true,
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc(fmt.Sprintf("/%s", databasesPath), func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost {
+					t.Fatalf("method got:%s want:%s", r.Method, http.MethodPost)
+				}
+
+				var got, want interface{}
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+				if err := json.Unmarshal([]byte(tc.wantBody), &want); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Fatalf("Diff: %s(-got +want)", diff)
+				}
+
+				fmt.Fprint(w, getDatabaseSON())
+			})
+
+			got, err := client.Databases.Create(context.Background(), tc.req)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if got.ID != "668d797c-76fa-4934-9b05-ad288df2d136" {
+				t.Fatalf("database not decoded got:%+v", got)
+			}
+		})
+	}
+}
+
+func TestDatabasesService_Update(t *testing.T) {
+	tcs := map[string]struct {
+		req      *UpdateDatabaseRequest
+		wantBody string
+		wantErr  bool
+	}{
+		"ok": {
+			&UpdateDatabaseRequest{
+				Title: []TextObject{
+					{Type: TextRichTextType, Text: &Text{Content: "Groceries"}},
+				},
+				Properties: map[string]*PropertyUpdate{
+					"Wine pairing": {Property: &TextPropertyConfig{}},
+					"cU^N":         {Name: "Cost"},
+					"Store availability": {Property: &SelectPropertyConfig{
+						Options: []SelectOption{
+							{ID: "d209b920-212c-4040-9d4a-bdf349dd8b2a", Color: RedColor},
+							{Name: "Gus's Community Market", Color: YellowColor},
+						},
+					}},
+					"Photo": nil,
+				},
+			},
+			`{
+				"title": [{"type": "text", "text": {"content": "Groceries"}}],
+				"properties": {
+					"Wine pairing": {"text": {}},
+					"cU^N": {"name": "Cost"},
+					"Store availability": {"select": {"options": [
+						{"id": "d209b920-212c-4040-9d4a-bdf349dd8b2a", "color": "red"},
+						{"name": "Gus's Community Market", "color": "yellow"}
+					]}},
+					"Photo": null
+				}
+			}`,
+			false,
+		},
+		"empty update": {
+			&UpdateDatabaseRequest{
+				Properties: map[string]*PropertyUpdate{"Photo": {}},
+			},
+			"",
+			true,
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			id := "668d797c-76fa-4934-9b05-ad288df2d136"
+			mux.HandleFunc(fmt.Sprintf("/%s/%s", databasesPath, id), func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPatch {
+					t.Fatalf("method got:%s want:%s", r.Method, http.MethodPatch)
+				}
+
+				var got, want interface{}
+				if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+				if err := json.Unmarshal([]byte(tc.wantBody), &want); err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+
+				if diff := cmp.Diff(got, want); diff != "" {
+					t.Fatalf("Diff: %s(-got +want)", diff)
+				}
+
+				fmt.Fprint(w, getDatabaseSON())
+			})
+
+			got, err := client.Databases.Update(context.Background(), id, tc.req)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if got.ID != id {
+				t.Fatalf("database not decoded got:%+v", got)
+			}
+		})
+	}
+}
+
+type invalidFilter struct{}
+
+func (invalidFilter) Validate() error {
+	return errInjected
+}
+
+func TestDatabasesService_Query_invalidFilter(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/db/query", databasesPath), func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("invalid query has been sent")
+	})
+
+	_, err := client.Databases.Query(context.Background(), "db", &DatabaseQuery{Filter: invalidFilter{}})
+	if !errors.Is(err, errInjected) {
+		t.Fatalf("expected validation error got:%v", err)
+	}
+}
+
+func TestFilterObject_json(t *testing.T) {
+	no, zero := false, 0.0
+	tcs := map[string]struct {
+		filter FilterObject
+		want   string
+	}{
+		"checkbox false": {
+			&PropertyFilter{Property: "Visited", PropertyCondition: PropertyCondition{Checkbox: &CheckboxFilter{Equals: &no}}},
+			`{"property":"Visited","checkbox":{"equals":false}}`,
+		},
+		"number zero": {
+			&PropertyFilter{Property: "Stock", PropertyCondition: PropertyCondition{Number: &NumberFilter{DoesNotEqual: &zero}}},
+			`{"property":"Stock","number":{"does_not_equal":0}}`,
+		},
+		"relative date": {
+			&PropertyFilter{Property: "Due", PropertyCondition: PropertyCondition{Date: &DateFilter{PastWeek: &struct{}{}}}},
+			`{"property":"Due","date":{"past_week":{}}}`,
+		},
+		"formula": {
+			&PropertyFilter{Property: "Late", PropertyCondition: PropertyCondition{Formula: &FormulaFilter{Checkbox: &CheckboxFilter{Equals: &no}}}},
+			`{"property":"Late","formula":{"checkbox":{"equals":false}}}`,
+		},
+		"rollup": {
+			&PropertyFilter{Property: "Tasks", PropertyCondition: PropertyCondition{Rollup: &RollupFilter{
+				Every: &PropertyCondition{Checkbox: &CheckboxFilter{Equals: &no}},
+			}}},
+			`{"property":"Tasks","rollup":{"every":{"checkbox":{"equals":false}}}}`,
+		},
+		"timestamp": {
+			&TimestampFilter{Timestamp: CreatedTime, CreatedTime: &DateFilter{NextMonth: &struct{}{}}},
+			`{"timestamp":"created_time","created_time":{"next_month":{}}}`,
+		},
+		"compound": {
+			&CompoundFilter{Or: []FilterObject{
+				&PropertyFilter{Property: "Visited", PropertyCondition: PropertyCondition{Checkbox: &CheckboxFilter{Equals: &no}}},
+			}},
+			`{"or":[{"property":"Visited","checkbox":{"equals":false}}]}`,
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := json.Marshal(tc.filter)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(string(got), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestDatabasesService_Query_invalidSort(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/db/query", databasesPath), func(w http.ResponseWriter, r *http.Request) {
+		t.Fatalf("invalid query has been sent")
+	})
+
+	_, err := client.Databases.Query(context.Background(), "db", &DatabaseQuery{
+		Sorts: []Sort{{Property: "Due", Timestamp: LastEditedTime, Direction: Ascending}},
+	})
+	if err == nil {
+		t.Fatalf("expected validation error")
+	}
+}
+
+func TestDatabase_UnmarshalJSON(t *testing.T) {
+	var db Database
+	if err := json.Unmarshal([]byte(getDatabaseSON()), &db); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if db.ID != "668d797c-76fa-4934-9b05-ad288df2d136" {
+		t.Fatalf("ID got:%s", db.ID)
+	}
+
+	want := &NumberPropertyConfig{Type: object.NumberPropertyType, ID: "cU^N", Format: "dollar"}
+	if diff := cmp.Diff(db.Properties["Price"], PropertyConfig(want)); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestDatabase_MarshalJSON_roundTrip(t *testing.T) {
+	var db Database
+	if err := json.Unmarshal([]byte(getDatabaseSON()), &db); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	b, err := json.Marshal(&db)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var got Database
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, db); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	options := got.Properties["Food group"].(*SelectPropertyConfig).Options
+	if len(options) != 3 {
+		t.Fatalf("select options got:%d want:3", len(options))
+	}
+}
+
+func TestDatabase_MarshalJSON_fromGo(t *testing.T) {
+	db := &Database{
+		Object: object.Database,
+		ID:     "668d797c-76fa-4934-9b05-ad288df2d136",
+		Title:  []TextObject{},
+		Properties: map[string]PropertyConfig{
+			"Name":       &TitlePropertyConfig{Type: object.TitlePropertyType, ID: "title"},
+			"Food group": &SelectPropertyConfig{Type: object.SelectPropertyType, ID: "TJmr", Options: []SelectOption{{Name: "🍎Fruit", Color: RedColor}}},
+			"Meals": &RelationPropertyConfig{
+				Type:               object.RelationPropertyType,
+				ID:                 "mxp^",
+				DatabaseID:         "668d797c-76fa-4934-9b05-ad288df2d137",
+				SyncedPropertyName: "Ingredients",
+				SyncedPropertyID:   "d]mP",
+			},
+		},
+	}
+
+	b, err := json.Marshal(db)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var got Database
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(&got, db); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
@@ -3,7 +3,9 @@ package notion
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sort"
 
 	"github.com/ketion-so/go-notion/notion/object"
 	"github.com/mitchellh/mapstructure"
@@ -24,18 +26,77 @@ type DatabasesService service
 //go:generate gomodifytags -file $GOFILE -struct Database -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct Database -add-tags json,mapstructure -w -transform snakecase
 type Database struct {
-	Object         object.Type         `json:"object" mapstructure:"object"`
-	ID             string              `json:"id" mapstructure:"id"`
-	CreatedTime    string              `json:"created_time" mapstructure:"created_time"`
-	LastEditedTime string              `json:"last_edited_time" mapstructure:"last_edited_time"`
-	Title          []TextObject        `json:"title" mapstructure:"title"`
-	Properties     map[string]Property `json:"properties" mapstructure:"properties"`
+	Object         object.Type               `json:"object" mapstructure:"object"`
+	ID             string                    `json:"id" mapstructure:"id"`
+	CreatedTime    string                    `json:"created_time" mapstructure:"created_time"`
+	LastEditedTime string                    `json:"last_edited_time" mapstructure:"last_edited_time"`
+	Title          []TextObject              `json:"title" mapstructure:"title"`
+	Properties     map[string]PropertyConfig `json:"properties" mapstructure:"properties"`
 }
 
 func (db *Database) GetObject() object.Type {
 	return db.Object
 }
 
+// UnmarshalJSON decodes a database as returned by the API, e.g. saved from a
+// previous Databases.Get.
+func (db *Database) UnmarshalJSON(b []byte) error {
+	data := database{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return err
+	}
+
+	d, err := convDatabase(&data)
+	if err != nil {
+		return err
+	}
+
+	*db = *d
+	return nil
+}
+
+// MarshalJSON encodes the database as returned by the API, with the schema of
+// each property keyed by its type, so that it can be decoded back.
+func (db *Database) MarshalJSON() ([]byte, error) {
+	properties := map[string]interface{}{}
+	for name, p := range db.Properties {
+		schema, err := propertySchema(p)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		if r, ok := p.(*RelationPropertyConfig); ok && r.SyncedPropertyID != "" {
+			schema[string(p.GetType())].(map[string]interface{})["synced_property_id"] = r.SyncedPropertyID
+		}
+
+		schema["type"] = p.GetType()
+		if id := propertyConfigID(p); id != "" {
+			schema["id"] = id
+		}
+		properties[name] = schema
+	}
+
+	title := db.Title
+	if title == nil {
+		title = []TextObject{}
+	}
+
+	return json.Marshal(&struct {
+		Object         object.Type            `json:"object"`
+		ID             string                 `json:"id"`
+		CreatedTime    string                 `json:"created_time"`
+		LastEditedTime string                 `json:"last_edited_time"`
+		Title          []TextObject           `json:"title"`
+		Properties     map[string]interface{} `json:"properties"`
+	}{
+		Object:         db.Object,
+		ID:             db.ID,
+		CreatedTime:    db.CreatedTime,
+		LastEditedTime: db.LastEditedTime,
+		Title:          title,
+		Properties:     properties,
+	})
+}
+
 //go:generate gomodifytags -file $GOFILE -struct database -clear-tags -w
 //go:generate gomodifytags --file $GOFILE --struct database -add-tags json,mapstructure -w -transform snakecase
 type database struct {
@@ -50,8 +111,8 @@ type database struct {
 // Get retrieves database by database ID.
 //
 // API doc: https://developers.notion.com/reference/get-database
-func (s *DatabasesService) Get(ctx context.Context, databaseID string) (*Database, error) {
-	resp, err := s.client.get(ctx, fmt.Sprintf("%s/%s", databasesPath, databaseID))
+func (s *DatabasesService) Get(ctx context.Context, databaseID string, opts ...RequestOption) (*Database, error) {
+	resp, err := s.client.get(ctx, newOperation("Databases.Get", databaseID), fmt.Sprintf("%s/%s", databasesPath, databaseID), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -67,115 +128,197 @@ func (s *DatabasesService) Get(ctx context.Context, databaseID string) (*Databas
 
 // DatabaseQuery is a query for database
 type DatabaseQuery struct {
-	Filter      map[CompoundFilterType]FilterObject `json:"filter,omitempty" mapstructure:"filter"`
-	Sorts       []Sort                              `json:"sorts,omitempty" mapstructure:"sort"`
-	StartCursor string                              `json:"start_cursor,omitempty" mapstructure:"start_cursor"`
-	PageSize    int32                               `json:"page_size,omitempty" mapstructure:"page_size"`
+	Filter      FilterObject `json:"filter,omitempty" mapstructure:"filter"`
+	Sorts       []Sort       `json:"sorts,omitempty" mapstructure:"sorts"`
+	StartCursor string       `json:"start_cursor,omitempty" mapstructure:"start_cursor"`
+	PageSize    int32        `json:"page_size,omitempty" mapstructure:"page_size"`
 }
 
+// FilterObject is the filter of a database query, e.g. built with the filter
+// package. Filters implementing Validate() error are validated before the
+// query is sent.
 type FilterObject interface{}
 
+type validator interface {
+	Validate() error
+}
+
+// PropertyFilter filters the pages by the value of one of their properties.
+// Exactly one condition of the embedded PropertyCondition must be set.
+//
+// API doc: https://developers.notion.com/reference/post-database-query-filter
+//go:generate gomodifytags --file $GOFILE --struct PropertyFilter -add-tags json,mapstructure -w -transform snakecase
+type PropertyFilter struct {
+	Property          string `json:"property" mapstructure:"property"`
+	PropertyCondition `mapstructure:",squash"`
+}
+
+// PropertyCondition is a condition on a property value, keyed by the type of
+// the property.
+//go:generate gomodifytags --file $GOFILE --struct PropertyCondition -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
+type PropertyCondition struct {
+	Title       *TextFilter        `json:"title,omitempty" mapstructure:"title"`
+	Text        *TextFilter        `json:"text,omitempty" mapstructure:"text"`
+	URL         *TextFilter        `json:"url,omitempty" mapstructure:"url"`
+	Email       *TextFilter        `json:"email,omitempty" mapstructure:"email"`
+	PhoneNumber *TextFilter        `json:"phone_number,omitempty" mapstructure:"phone_number"`
+	Number      *NumberFilter      `json:"number,omitempty" mapstructure:"number"`
+	Checkbox    *CheckboxFilter    `json:"checkbox,omitempty" mapstructure:"checkbox"`
+	Select      *SelectFilter      `json:"select,omitempty" mapstructure:"select"`
+	MultiSelect *MultiSelectFilter `json:"multi_select,omitempty" mapstructure:"multi_select"`
+	Date        *DateFilter        `json:"date,omitempty" mapstructure:"date"`
+	People      *PeopleFilter      `json:"people,omitempty" mapstructure:"people"`
+	Files       *FilesFilter       `json:"files,omitempty" mapstructure:"files"`
+	Relation    *RelationFilter    `json:"relation,omitempty" mapstructure:"relation"`
+	Formula     *FormulaFilter     `json:"formula,omitempty" mapstructure:"formula"`
+	Rollup      *RollupFilter      `json:"rollup,omitempty" mapstructure:"rollup"`
+}
+
+// Timestamp is a timestamp of the pages which can be filtered and sorted on.
+type Timestamp string
+
+const (
+	CreatedTime    Timestamp = "created_time"
+	LastEditedTime Timestamp = "last_edited_time"
+)
+
+// TimestampFilter filters the pages by their creation or last edition time.
+// The condition matching the timestamp must be set.
+//go:generate gomodifytags --file $GOFILE --struct TimestampFilter -add-tags json,mapstructure -w -transform snakecase
+type TimestampFilter struct {
+	Timestamp      Timestamp   `json:"timestamp" mapstructure:"timestamp"`
+	CreatedTime    *DateFilter `json:"created_time,omitempty" mapstructure:"created_time"`
+	LastEditedTime *DateFilter `json:"last_edited_time,omitempty" mapstructure:"last_edited_time"`
+}
+
+// CompoundFilter combines filters, which can be compound filters themselves.
+// Only one of And and Or must be set.
+//go:generate gomodifytags --file $GOFILE --struct CompoundFilter -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
+type CompoundFilter struct {
+	And []FilterObject `json:"and,omitempty" mapstructure:"and"`
+	Or  []FilterObject `json:"or,omitempty" mapstructure:"or"`
+}
+
 // TextFilter filters text properties.
-//go:generate gomodifytags --file $GOFILE --struct database -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
+//go:generate gomodifytags --file $GOFILE --struct TextFilter -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
 type TextFilter struct {
-	Property       string `json:"property" mapstructure:"property"`
-	Equals         string `json:"equals,omitempty" mapstructure:"equals"`
-	DoesNotEqual   string `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
-	Contains       string `json:"contains,omitempty" mapstructure:"contains"`
-	DoesNotContain string `json:"does_not_contain,omitempty" mapstructure:"does_not_contain"`
-	StartsWith     string `json:"starts_with,omitempty" mapstructure:"starts_with"`
-	EndsWith       string `json:"ends_with,omitempty" mapstructure:"ends_with"`
-	IsEmpty        bool   `json:"is_empty,omitempty" mapstructure:"is_empty"`
-	IsNotEmpty     bool   `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
+	Equals         *string `json:"equals,omitempty" mapstructure:"equals"`
+	DoesNotEqual   *string `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
+	Contains       *string `json:"contains,omitempty" mapstructure:"contains"`
+	DoesNotContain *string `json:"does_not_contain,omitempty" mapstructure:"does_not_contain"`
+	StartsWith     *string `json:"starts_with,omitempty" mapstructure:"starts_with"`
+	EndsWith       *string `json:"ends_with,omitempty" mapstructure:"ends_with"`
+	IsEmpty        bool    `json:"is_empty,omitempty" mapstructure:"is_empty"`
+	IsNotEmpty     bool    `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
 }
 
 // NumberFilter filters number properties.
+//go:generate gomodifytags --file $GOFILE --struct NumberFilter -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
 type NumberFilter struct {
-	Property             string  `json:"property" mapstructure:"property"`
-	Equals               float64 `json:"equals,omitempty" mapstructure:"equals"`
-	GreaterThan          float64 `json:"greater_than,omitempty" mapstructure:"greater_than"`
-	LessThan             float64 `json:"less_than,omitempty" mapstructure:"less_than"`
-	GreaterThanOrEqualTo float64 `json:"greater_than_or_equal_to,omitempty" mapstructure:"greater_than_or_equal_to"`
-	LessThanOrEqualTo    float64 `json:"less_than_or_equal_to,omitempty" mapstructure:"less_than_or_equal_to"`
-	IsEmpty              bool    `json:"is_empty,omitempty" mapstructure:"is_empty"`
-	IsNotEmpty           bool    `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
+	Equals               *float64 `json:"equals,omitempty" mapstructure:"equals"`
+	DoesNotEqual         *float64 `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
+	GreaterThan          *float64 `json:"greater_than,omitempty" mapstructure:"greater_than"`
+	LessThan             *float64 `json:"less_than,omitempty" mapstructure:"less_than"`
+	GreaterThanOrEqualTo *float64 `json:"greater_than_or_equal_to,omitempty" mapstructure:"greater_than_or_equal_to"`
+	LessThanOrEqualTo    *float64 `json:"less_than_or_equal_to,omitempty" mapstructure:"less_than_or_equal_to"`
+	IsEmpty              bool     `json:"is_empty,omitempty" mapstructure:"is_empty"`
+	IsNotEmpty           bool     `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
 }
 
-// CheckboxFilter filters object properties.
+// CheckboxFilter filters checkbox properties.
+//go:generate gomodifytags --file $GOFILE --struct CheckboxFilter -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
 type CheckboxFilter struct {
-	Property    string `json:"property" mapstructure:"property"`
-	Equals      bool   `json:"equals,omitempty" mapstructure:"equals"`
-	DoeNotEqual bool   `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
+	Equals       *bool `json:"equals,omitempty" mapstructure:"equals"`
+	DoesNotEqual *bool `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
 }
 
 // SelectFilter filters select properties.
+//go:generate gomodifytags --file $GOFILE --struct SelectFilter -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
 type SelectFilter struct {
-	Property    string `json:"property" mapstructure:"property"`
-	Equals      string `json:"equals,omitempty" mapstructure:"equals"`
-	DoeNotEqual string `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
-	IsEmpty     bool   `json:"is_empty,omitempty" mapstructure:"is_empty"`
-	IsNotEmpty  bool   `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
+	Equals       *string `json:"equals,omitempty" mapstructure:"equals"`
+	DoesNotEqual *string `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
+	IsEmpty      bool    `json:"is_empty,omitempty" mapstructure:"is_empty"`
+	IsNotEmpty   bool    `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
 }
 
-// MultiSelectFilter filters select properties.
+// MultiSelectFilter filters multi select properties.
+//go:generate gomodifytags --file $GOFILE --struct MultiSelectFilter -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
 type MultiSelectFilter struct {
-	Property    string `json:"property" mapstructure:"property"`
-	Equals      string `json:"equals,omitempty" mapstructure:"equals"`
-	DoeNotEqual string `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
-	IsEmpty     bool   `json:"is_empty,omitempty" mapstructure:"is_empty"`
-	IsNotEmpty  bool   `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
-}
-
-// DataFilter filters data properties.
-type DataFilter struct {
-	Property   string      `json:"property" mapstructure:"property"`
-	Equals     string      `json:"equals,omitempty" mapstructure:"equals"`
-	Before     string      `json:"before,omitempty" mapstructure:"before"`
-	After      string      `json:"after,omitempty" mapstructure:"after"`
-	OnOrBefore string      `json:"on_or_before,omitempty" mapstructure:"on_or_before"`
-	IsEmpty    bool        `json:"is_empty,omitempty" mapstructure:"is_empty"`
-	IsNotEmpty bool        `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
-	OnOrAfter  string      `json:"on_or_after,omitempty" mapstructure:"on_or_after"`
-	PassWeek   interface{} `json:"pass_week,omitempty" mapstructure:"pass_week"`
-	PassMonth  interface{} `json:"pass_month,omitempty" mapstructure:"pass_month"`
-	PassYear   interface{} `json:"pass_year,omitempty" mapstructure:"pass_year"`
-	NextWeek   interface{} `json:"next_weeb,omitempty" mapstructure:"next_weeb"`
-	NextMonth  interface{} `json:"next_month,omitempty" mapstructure:"next_month"`
-	NextYear   interface{} `json:"next_year,omitempty" mapstructure:"next_year"`
-}
-
-// PeopleFilter filters select properties.
+	Contains       *string `json:"contains,omitempty" mapstructure:"contains"`
+	DoesNotContain *string `json:"does_not_contain,omitempty" mapstructure:"does_not_contain"`
+	IsEmpty        bool    `json:"is_empty,omitempty" mapstructure:"is_empty"`
+	IsNotEmpty     bool    `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
+}
+
+// DateFilter filters date properties. Dates are ISO 8601 strings, e.g.
+// "2021-05-10" or "2021-05-10T12:00:00Z".
+//go:generate gomodifytags --file $GOFILE --struct DateFilter -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
+type DateFilter struct {
+	Equals     *string   `json:"equals,omitempty" mapstructure:"equals"`
+	Before     *string   `json:"before,omitempty" mapstructure:"before"`
+	After      *string   `json:"after,omitempty" mapstructure:"after"`
+	OnOrBefore *string   `json:"on_or_before,omitempty" mapstructure:"on_or_before"`
+	OnOrAfter  *string   `json:"on_or_after,omitempty" mapstructure:"on_or_after"`
+	PastWeek   *struct{} `json:"past_week,omitempty" mapstructure:"past_week"`
+	PastMonth  *struct{} `json:"past_month,omitempty" mapstructure:"past_month"`
+	PastYear   *struct{} `json:"past_year,omitempty" mapstructure:"past_year"`
+	NextWeek   *struct{} `json:"next_week,omitempty" mapstructure:"next_week"`
+	NextMonth  *struct{} `json:"next_month,omitempty" mapstructure:"next_month"`
+	NextYear   *struct{} `json:"next_year,omitempty" mapstructure:"next_year"`
+	IsEmpty    bool      `json:"is_empty,omitempty" mapstructure:"is_empty"`
+	IsNotEmpty bool      `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
+}
+
+// DataFilter filters date properties.
+//
+// Deprecated: use DateFilter.
+type DataFilter = DateFilter
+
+// PeopleFilter filters people properties by user ID.
+//go:generate gomodifytags --file $GOFILE --struct PeopleFilter -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
 type PeopleFilter struct {
-	Property    string `json:"property" mapstructure:"property"`
-	Equals      string `json:"equals,omitempty" mapstructure:"equals"`
-	DoeNotEqual string `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
-	IsEmpty     bool   `json:"is_empty,omitempty" mapstructure:"is_empty"`
-	IsNotEmpty  bool   `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
+	Contains       *string `json:"contains,omitempty" mapstructure:"contains"`
+	DoesNotContain *string `json:"does_not_contain,omitempty" mapstructure:"does_not_contain"`
+	IsEmpty        bool    `json:"is_empty,omitempty" mapstructure:"is_empty"`
+	IsNotEmpty     bool    `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
 }
 
-// FilesFilter filters select properties.
+// FilesFilter filters files properties.
+//go:generate gomodifytags --file $GOFILE --struct FilesFilter -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
 type FilesFilter struct {
-	Property   string `json:"property" mapstructure:"property"`
-	IsEmpty    bool   `json:"is_empty,omitempty" mapstructure:"is_empty"`
-	IsNotEmpty bool   `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
+	IsEmpty    bool `json:"is_empty,omitempty" mapstructure:"is_empty"`
+	IsNotEmpty bool `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
 }
 
-// RelationFilter filters select properties.
+// RelationFilter filters relation properties by page ID.
+//go:generate gomodifytags --file $GOFILE --struct RelationFilter -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
 type RelationFilter struct {
-	Property    string `json:"property" mapstructure:"property"`
-	Equals      string `json:"equals,omitempty" mapstructure:"equals"`
-	DoeNotEqual string `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
-	IsEmpty     bool   `json:"is_empty,omitempty" mapstructure:"is_empty"`
-	IsNotEmpty  bool   `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
+	Contains       *string `json:"contains,omitempty" mapstructure:"contains"`
+	DoesNotContain *string `json:"does_not_contain,omitempty" mapstructure:"does_not_contain"`
+	IsEmpty        bool    `json:"is_empty,omitempty" mapstructure:"is_empty"`
+	IsNotEmpty     bool    `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
 }
 
-// FormulaFilter filters select properties.
+// FormulaFilter filters formula properties, according to the type of the
+// formula result.
+//go:generate gomodifytags --file $GOFILE --struct FormulaFilter -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
 type FormulaFilter struct {
-	Property string          `json:"property" mapstructure:"property"`
-	Text     *TextFilter     `json:"equals,omitempty" mapstructure:"equals"`
-	Checkbox *CheckboxFilter `json:"does_not_equal,omitempty" mapstructure:"does_not_equal"`
-	Number   *NumberFilter   `json:"is_empty,omitempty" mapstructure:"is_empty"`
-	Date     *DataFilter     `json:"is_not_empty,omitempty" mapstructure:"is_not_empty"`
+	Text     *TextFilter     `json:"text,omitempty" mapstructure:"text"`
+	Checkbox *CheckboxFilter `json:"checkbox,omitempty" mapstructure:"checkbox"`
+	Number   *NumberFilter   `json:"number,omitempty" mapstructure:"number"`
+	Date     *DateFilter     `json:"date,omitempty" mapstructure:"date"`
+}
+
+// RollupFilter filters rollup properties. Any, Every and None apply the
+// condition to the items of rollups computing an array, Number and Date to
+// rollups computing a single value.
+//go:generate gomodifytags --file $GOFILE --struct RollupFilter -add-tags json,mapstructure -w -transform snakecase -add-options json=omitempty
+type RollupFilter struct {
+	Any    *PropertyCondition `json:"any,omitempty" mapstructure:"any"`
+	Every  *PropertyCondition `json:"every,omitempty" mapstructure:"every"`
+	None   *PropertyCondition `json:"none,omitempty" mapstructure:"none"`
+	Number *NumberFilter      `json:"number,omitempty" mapstructure:"number"`
+	Date   *DateFilter        `json:"date,omitempty" mapstructure:"date"`
 }
 
 // CompoundFilterType is a type for compound filters.
@@ -206,8 +349,22 @@ type queryDatabaseResults struct {
 // Query queries a database.
 //
 // API doc: https://developers.notion.com/reference/post-databases-query
-func (s *DatabasesService) Query(ctx context.Context, databaseID string, query *DatabaseQuery) (*QueryDatabaseResults, error) {
-	resp, err := s.client.post(ctx, fmt.Sprintf("%s/%s/query", databasesPath, databaseID), query)
+func (s *DatabasesService) Query(ctx context.Context, databaseID string, query *DatabaseQuery, opts ...RequestOption) (*QueryDatabaseResults, error) {
+	if query != nil {
+		if v, ok := query.Filter.(validator); ok {
+			if err := v.Validate(); err != nil {
+				return nil, fmt.Errorf("invalid filter: %w", err)
+			}
+		}
+
+		for i := range query.Sorts {
+			if err := query.Sorts[i].Validate(); err != nil {
+				return nil, fmt.Errorf("invalid sort %d: %w", i, err)
+			}
+		}
+	}
+
+	resp, err := s.client.post(ctx, newOperation("Databases.Query", databaseID), fmt.Sprintf("%s/%s/query", databasesPath, databaseID), query, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -283,8 +440,8 @@ type ListDatabaseResponse struct {
 // List lists database.
 //
 // API doc: https://developers.notion.com/reference/get-databases
-func (s *DatabasesService) List(ctx context.Context) (*ListDatabaseResponse, error) {
-	resp, err := s.client.get(ctx, databasesPath)
+func (s *DatabasesService) List(ctx context.Context, lopts *ListOptions, opts ...RequestOption) (*ListDatabaseResponse, error) {
+	resp, err := s.client.get(ctx, newOperation("Databases.List", ""), addOptions(databasesPath, lopts), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -298,8 +455,235 @@ func (s *DatabasesService) List(ctx context.Context) (*ListDatabaseResponse, err
 	return results, nil
 }
 
+// CreateDatabaseRequest represents the request body of Databases.Create.
+//
+// The schema of each property is taken from the concrete PropertyConfig type,
+// e.g. the options of a SelectPropertyConfig, the format of a
+// NumberPropertyConfig or the expression of a FormulaPropertyConfig. Exactly
+// one title property is required.
+//go:generate gomodifytags --file $GOFILE --struct CreateDatabaseRequest -add-tags json,mapstructure -w -transform snakecase
+type CreateDatabaseRequest struct {
+	Parent     *PageParent               `json:"parent" mapstructure:"parent"`
+	Title      []TextObject              `json:"title" mapstructure:"title"`
+	Properties map[string]PropertyConfig `json:"properties" mapstructure:"properties"`
+}
+
+// Validate checks that the request sets the parent page and exactly one
+// title property.
+func (r *CreateDatabaseRequest) Validate() error {
+	if r == nil {
+		return errors.New("request is nil")
+	}
+	if r.Parent == nil {
+		return errors.New("parent page is required to create a database")
+	}
+
+	titles := []string{}
+	for name, p := range r.Properties {
+		if _, ok := p.(*TitlePropertyConfig); ok {
+			titles = append(titles, name)
+		}
+	}
+	sort.Strings(titles)
+
+	switch len(titles) {
+	case 0:
+		return errors.New("a title property is required to create a database")
+	case 1:
+		return nil
+	default:
+		return fmt.Errorf("database must have exactly one title property, got %q", titles)
+	}
+}
+
+// MarshalJSON encodes the request with the property schema expected by the API.
+func (r *CreateDatabaseRequest) MarshalJSON() ([]byte, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+
+	properties := map[string]interface{}{}
+	for name, p := range r.Properties {
+		schema, err := propertySchema(p)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		properties[name] = schema
+	}
+
+	title := r.Title
+	if title == nil {
+		title = []TextObject{}
+	}
+
+	return json.Marshal(&struct {
+		Parent     *PageParent            `json:"parent"`
+		Title      []TextObject           `json:"title"`
+		Properties map[string]interface{} `json:"properties"`
+	}{
+		Parent:     &PageParent{Type: object.PageParentType, PageID: r.Parent.PageID},
+		Title:      title,
+		Properties: properties,
+	})
+}
+
+// propertySchema returns the schema of the property as expected by the API,
+// keyed by the type of the property.
+func propertySchema(p PropertyConfig) (map[string]interface{}, error) {
+	if p == nil {
+		return nil, errors.New("property schema is nil")
+	}
+
+	config := map[string]interface{}{}
+	switch p := p.(type) {
+	case *NumberPropertyConfig:
+		if p.Format != "" {
+			config["format"] = p.Format
+		}
+	case *SelectPropertyConfig:
+		options := p.Options
+		if options == nil {
+			options = []SelectOption{}
+		}
+		config["options"] = options
+	case *MultiSelectPropertyConfig:
+		options := p.Options
+		if options == nil {
+			options = []MultiSelectOption{}
+		}
+		config["options"] = options
+	case *FormulaPropertyConfig:
+		config["expression"] = p.Expression
+	case *RelationPropertyConfig:
+		if p.DatabaseID == "" {
+			return nil, errors.New("relation property requires the related database ID")
+		}
+		config["database_id"] = p.DatabaseID
+		if p.SyncedPropertyName != "" {
+			config["synced_property_name"] = p.SyncedPropertyName
+		}
+	case *RollupPropertyConfig:
+		if p.RelationPropertyName == "" && p.RelationPropertyID == "" {
+			return nil, errors.New("rollup property requires its relation property")
+		}
+		for k, v := range map[string]string{
+			"relation_property_name": p.RelationPropertyName,
+			"relation_property_id":   p.RelationPropertyID,
+			"rollup_property_name":   p.RollupPropertyName,
+			"rollup_property_id":     p.RollupPropertyID,
+			"function":               p.Function,
+		} {
+			if v != "" {
+				config[k] = v
+			}
+		}
+	case *TitlePropertyConfig, *TextPropertyConfig, *DatePropertyConfig, *PeoplePropertyConfig,
+		*FilesPropertyConfig, *CheckboxPropertyConfig, *URLPropertyConfig, *EmailPropertyConfig,
+		*PhoneNumberPropertyConfig, *CreatedTimePropertyConfig, *CreatedByPropertyConfig,
+		*LastEditedTimePropertyConfig, *LastEditedByPropertyConfig:
+	default:
+		return nil, fmt.Errorf("%T is not supported property type", p)
+	}
+
+	return map[string]interface{}{string(p.GetType()): config}, nil
+}
+
+// Create creates a database as a subpage of the parent page.
+//
+// API doc: https://developers.notion.com/reference/create-a-database
+func (s *DatabasesService) Create(ctx context.Context, dreq *CreateDatabaseRequest, opts ...RequestOption) (*Database, error) {
+	if err := dreq.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid database: %w", err)
+	}
+
+	resp, err := s.client.post(ctx, newOperation("Databases.Create", ""), databasesPath, dreq, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data := database{}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	return convDatabase(&data)
+}
+
+// UpdateDatabaseRequest represents the request body of Databases.Update.
+//
+// Properties are keyed by property name or ID. A nil PropertyUpdate removes the
+// property from the database.
+type UpdateDatabaseRequest struct {
+	Title      []TextObject
+	Properties map[string]*PropertyUpdate
+}
+
+// PropertyUpdate represents the change of a database property.
+type PropertyUpdate struct {
+	// Name renames the property when not empty.
+	Name string
+
+	// Property replaces the schema of the property, changing its type or its
+	// select options, or adds the property when it doesn't exist yet.
+	Property PropertyConfig
+}
+
+// MarshalJSON encodes the request with the property schema expected by the API.
+func (r *UpdateDatabaseRequest) MarshalJSON() ([]byte, error) {
+	properties := map[string]interface{}{}
+	for key, u := range r.Properties {
+		if u == nil {
+			properties[key] = nil
+			continue
+		}
+
+		if u.Name == "" && u.Property == nil {
+			return nil, fmt.Errorf("%s: empty property update", key)
+		}
+
+		schema := map[string]interface{}{}
+		if u.Property != nil {
+			var err error
+			if schema, err = propertySchema(u.Property); err != nil {
+				return nil, fmt.Errorf("%s: %w", key, err)
+			}
+		}
+		if u.Name != "" {
+			schema["name"] = u.Name
+		}
+		properties[key] = schema
+	}
+
+	return json.Marshal(&struct {
+		Title      []TextObject           `json:"title,omitempty"`
+		Properties map[string]interface{} `json:"properties,omitempty"`
+	}{
+		Title:      r.Title,
+		Properties: properties,
+	})
+}
+
+// Update updates the title or the property schema of a database.
+//
+// API doc: https://developers.notion.com/reference/update-a-database
+func (s *DatabasesService) Update(ctx context.Context, databaseID string, dreq *UpdateDatabaseRequest, opts ...RequestOption) (*Database, error) {
+	resp, err := s.client.patch(ctx, newOperation("Databases.Update", databaseID), fmt.Sprintf("%s/%s", databasesPath, databaseID), dreq, opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	data := database{}
+	if err := json.NewDecoder(resp.Body).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	return convDatabase(&data)
+}
+
 func convDatabase(data *database) (*Database, error) {
-	properties, err := convProperties(data.Properties)
+	properties, err := convPropertyConfigs(data.Properties)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,167 @@
+package notion
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+const (
+	requestIDHeader = "X-Notion-Request-Id"
+
+	// maxErrorBodySize caps how much of an error response is kept in memory.
+	maxErrorBodySize = 1 << 20
+)
+
+// Sentinel errors to be used with errors.Is. An *Error matches a sentinel
+// when both carry the same error code.
+var (
+	ErrInvalidJSON                   = &Error{Code: object.ErrInvalidJSON, Message: "invalid json"}
+	ErrInvalidRequestURL             = &Error{Code: object.ErrInvalidRequestURL, Message: "invalid request url"}
+	ErrInvalidRequest                = &Error{Code: object.ErrInvalidRequest, Message: "invalid request"}
+	ErrValidation                    = &Error{Code: object.ErrValidationError, Message: "validation error"}
+	ErrMissingVersion                = &Error{Code: object.ErrMissingVersion, Message: "missing version"}
+	ErrUnauthorized                  = &Error{Code: object.ErrUnauthorized, Message: "unauthorized"}
+	ErrRestrictedResource            = &Error{Code: object.ErrRestrictedResource, Message: "restricted resource"}
+	ErrObjectNotFound                = &Error{Code: object.ErrObjectNotFound, Message: "object not found"}
+	ErrConflict                      = &Error{Code: object.ErrConflictError, Message: "conflict"}
+	ErrRateLimited                   = &Error{Code: object.ErrRateLimited, Message: "rate limited"}
+	ErrInternalServer                = &Error{Code: object.ErrInternalServer, Message: "internal server error"}
+	ErrServiceUnavailable            = &Error{Code: object.ErrServiceUnavailable, Message: "service unavailable"}
+	ErrDatabaseConnectionUnavailable = &Error{Code: object.ErrDatabaseConnectionUnavailable, Message: "database connection unavailable"}
+	ErrGatewayTimeout                = &Error{Code: object.ErrGatewayTimeout, Message: "gateway timeout"}
+)
+
+// Error represents error response from Notion
+type Error struct {
+	Object    object.Type      `json:"object" mapstructure:"object"`
+	Status    int              `json:"status" mapstructure:"status"`
+	Code      object.ErrorCode `json:"code" mapstructure:"code"`
+	Message   string           `json:"message" mapstructure:"message"`
+	RequestID string           `json:"request_id,omitempty" mapstructure:"request_id"`
+
+	// HTTPStatus is the status code of the HTTP response.
+	HTTPStatus int `json:"-" mapstructure:"-"`
+	// Body is the raw body of the HTTP response.
+	Body []byte `json:"-" mapstructure:"-"`
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	if e.Message != "" {
+		return e.Message
+	}
+
+	if e.Code != "" {
+		return string(e.Code)
+	}
+
+	return http.StatusText(e.HTTPStatus)
+}
+
+// Is reports whether the target is an *Error with the same error code.
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	if !ok || t.Code == "" {
+		return false
+	}
+
+	return t.Code == e.Code
+}
+
+// IsRetryable reports whether the error is transient so the request may be retried.
+func IsRetryable(err error) bool {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		if apiErr.Code == object.ErrRateLimited {
+			return true
+		}
+
+		switch apiErr.HTTPStatus {
+		case http.StatusTooManyRequests, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+			return true
+		}
+		return false
+	}
+
+	return isTransientNetworkError(err)
+}
+
+// IsNotFound reports whether the error is an object_not_found error.
+func IsNotFound(err error) bool {
+	return errors.Is(err, ErrObjectNotFound)
+}
+
+// IsRateLimited reports whether the error is a rate_limited error.
+func IsRateLimited(err error) bool {
+	return errors.Is(err, ErrRateLimited)
+}
+
+// newError builds the *Error of a failed response. Bodies which are not a
+// Notion error object, e.g. from a proxy, are kept in Body and the error code
+// is derived from the HTTP status.
+func newError(resp *http.Response) *Error {
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodySize))
+
+	apiErr := &Error{}
+	if err := json.Unmarshal(body, apiErr); err != nil {
+		apiErr = &Error{
+			Object:  object.Error,
+			Message: strings.TrimSpace(string(body)),
+		}
+
+		if apiErr.Message == "" || !isText(resp.Header) {
+			apiErr.Message = http.StatusText(resp.StatusCode)
+		}
+	}
+
+	if apiErr.Code == "" {
+		apiErr.Code = errorCodeFromStatus(resp.StatusCode)
+	}
+
+	if apiErr.Status == 0 {
+		apiErr.Status = resp.StatusCode
+	}
+
+	if apiErr.RequestID == "" {
+		apiErr.RequestID = resp.Header.Get(requestIDHeader)
+	}
+
+	apiErr.HTTPStatus = resp.StatusCode
+	apiErr.Body = body
+	return apiErr
+}
+
+func isText(h http.Header) bool {
+	ct := h.Get("Content-Type")
+	return ct == "" || strings.HasPrefix(ct, "text/plain") || strings.HasPrefix(ct, "application/json")
+}
+
+func errorCodeFromStatus(status int) object.ErrorCode {
+	switch status {
+	case http.StatusBadRequest:
+		return object.ErrInvalidRequest
+	case http.StatusUnauthorized:
+		return object.ErrUnauthorized
+	case http.StatusForbidden:
+		return object.ErrRestrictedResource
+	case http.StatusNotFound:
+		return object.ErrObjectNotFound
+	case http.StatusConflict:
+		return object.ErrConflictError
+	case http.StatusTooManyRequests:
+		return object.ErrRateLimited
+	case http.StatusInternalServerError:
+		return object.ErrInternalServer
+	case http.StatusServiceUnavailable:
+		return object.ErrServiceUnavailable
+	case http.StatusGatewayTimeout:
+		return object.ErrGatewayTimeout
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,100 @@
+package notion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"testing"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+func TestError_Is(t *testing.T) {
+	tcs := map[string]struct {
+		err    error
+		target error
+		want   bool
+	}{
+		"same code": {
+			&Error{Code: object.ErrObjectNotFound, Message: "Could not find page"},
+			ErrObjectNotFound,
+			true,
+		},
+		"wrapped": {
+			fmt.Errorf("get page: %w", &Error{Code: object.ErrRateLimited}),
+			ErrRateLimited,
+			true,
+		},
+		"other code": {
+			&Error{Code: object.ErrValidationError},
+			ErrObjectNotFound,
+			false,
+		},
+		"not an api error": {
+			io.EOF,
+			ErrObjectNotFound,
+			false,
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			if got := errors.Is(tc.err, tc.target); got != tc.want {
+				t.Fatalf("errors.Is got:%v want:%v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsRetryable(t *testing.T) {
+	tcs := map[string]struct {
+		err  error
+		want bool
+	}{
+		"rate limited":        {&Error{Code: object.ErrRateLimited, HTTPStatus: http.StatusTooManyRequests}, true},
+		"bad gateway":         {&Error{HTTPStatus: http.StatusBadGateway}, true},
+		"service unavailable": {&Error{Code: object.ErrServiceUnavailable, HTTPStatus: http.StatusServiceUnavailable}, true},
+		"gateway timeout":     {&Error{HTTPStatus: http.StatusGatewayTimeout}, true},
+		"not found":           {&Error{Code: object.ErrObjectNotFound, HTTPStatus: http.StatusNotFound}, false},
+		"validation":          {&Error{Code: object.ErrValidationError, HTTPStatus: http.StatusBadRequest}, false},
+		"unexpected eof":      {io.ErrUnexpectedEOF, true},
+		"canceled":            {context.Canceled, false},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			if got := IsRetryable(tc.err); got != tc.want {
+				t.Fatalf("IsRetryable got:%v want:%v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", pagesPath, "missing"), func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(requestIDHeader, "f5e0c1a7")
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"object": "error", "status": 404, "code": "object_not_found", "message": "Could not find page"}`)
+	})
+
+	_, err := client.Pages.Get(context.Background(), "missing")
+	if !IsNotFound(err) {
+		t.Fatalf("IsNotFound got:false for %v", err)
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) || apiErr.RequestID != "f5e0c1a7" {
+		t.Fatalf("request id not set: %+v", err)
+	}
+}
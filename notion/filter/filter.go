@@ -0,0 +1,126 @@
+// Package filter builds the filters of database queries.
+//
+// Property filters are created from the property name and type, and grouped
+// with And and Or:
+//
+//	f := filter.And(
+//		filter.Text("Name").Contains("Bridge"),
+//		filter.Or(
+//			filter.Checkbox("Visited").Equals(false),
+//			filter.Number("Rating").GreaterThan(4),
+//		),
+//	)
+//	results, err := client.Databases.Query(ctx, databaseID, &notion.DatabaseQuery{Filter: f})
+//
+// Rollup properties are filtered on their items or on their computed value,
+// and pages on their timestamps:
+//
+//	filter.Rollup("Tasks").Every().Checkbox().Equals(true)
+//	filter.LastEditedTime().PastWeek()
+//
+// Filters are validated before the query is sent.
+//
+// API doc: https://developers.notion.com/reference/post-database-query-filter
+package filter
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// MaxDepth is the maximum nesting of compound filters accepted by the API: a
+// compound filter can hold compound filters, which can only hold property
+// filters.
+const MaxDepth = 2
+
+var (
+	// ErrEmptyCompound is returned when a compound filter holds no filter.
+	ErrEmptyCompound = errors.New("compound filter holds no filter")
+
+	// ErrTooDeep is returned when compound filters are nested deeper than
+	// MaxDepth.
+	ErrTooDeep = fmt.Errorf("compound filters are nested deeper than %d levels", MaxDepth)
+
+	// ErrNoProperty is returned when a property filter has no property name.
+	ErrNoProperty = errors.New("property filter has no property name")
+)
+
+// Filter is a filter of a database query.
+type Filter interface {
+	json.Marshaler
+
+	// Validate reports whether the API would accept the filter.
+	Validate() error
+
+	depth() int
+}
+
+type compound struct {
+	op      string
+	filters []Filter
+}
+
+// And returns a filter matching the pages matching all the filters.
+func And(filters ...Filter) Filter {
+	return &compound{op: "and", filters: filters}
+}
+
+// Or returns a filter matching the pages matching any of the filters.
+func Or(filters ...Filter) Filter {
+	return &compound{op: "or", filters: filters}
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c *compound) MarshalJSON() ([]byte, error) {
+	filters := c.filters
+	if filters == nil {
+		filters = []Filter{}
+	}
+	return json.Marshal(map[string][]Filter{c.op: filters})
+}
+
+// Validate implements the Filter interface.
+func (c *compound) Validate() error {
+	if c.depth() > MaxDepth {
+		return ErrTooDeep
+	}
+	return c.validate()
+}
+
+func (c *compound) validate() error {
+	if len(c.filters) == 0 {
+		return fmt.Errorf("%s: %w", c.op, ErrEmptyCompound)
+	}
+
+	for i, f := range c.filters {
+		if f == nil {
+			return fmt.Errorf("%s[%d]: nil filter", c.op, i)
+		}
+
+		var err error
+		if child, ok := f.(*compound); ok {
+			err = child.validate()
+		} else {
+			err = f.Validate()
+		}
+		if err != nil {
+			return fmt.Errorf("%s[%d]: %w", c.op, i, err)
+		}
+	}
+
+	return nil
+}
+
+func (c *compound) depth() int {
+	d := 0
+	for _, f := range c.filters {
+		if f == nil {
+			continue
+		}
+		if fd := f.depth(); fd > d {
+			d = fd
+		}
+	}
+	return d + 1
+}
@@ -0,0 +1,99 @@
+package filter
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func assertJSON(t *testing.T, f Filter, want string) {
+	t.Helper()
+
+	b, err := json.Marshal(f)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var got, w interface{}
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, w); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestCompound(t *testing.T) {
+	f := And(
+		Text("Name").Contains("Bridge"),
+		Or(
+			Checkbox("Visited").Equals(false),
+			Number("Rating").GreaterThan(4),
+		),
+	)
+
+	if err := f.Validate(); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	assertJSON(t, f, `{"and": [
+		{"property": "Name", "text": {"contains": "Bridge"}},
+		{"or": [
+			{"property": "Visited", "checkbox": {"equals": false}},
+			{"property": "Rating", "number": {"greater_than": 4}}
+		]}
+	]}`)
+}
+
+func TestFilter_Validate(t *testing.T) {
+	tcs := map[string]struct {
+		filter Filter
+		want   error
+	}{
+		"property": {
+			Select("Status").Equals("Done"),
+			nil,
+		},
+		"max depth": {
+			And(Or(Text("Name").IsEmpty())),
+			nil,
+		},
+		"too deep": {
+			And(Or(And(Text("Name").IsEmpty()))),
+			ErrTooDeep,
+		},
+		"empty compound": {
+			And(Text("Name").IsEmpty(), Or()),
+			ErrEmptyCompound,
+		},
+		"no property": {
+			Or(Number("").Equals(1)),
+			ErrNoProperty,
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			err := tc.filter.Validate()
+			if tc.want == nil {
+				if err != nil {
+					t.Fatalf("Failed: %v", err)
+				}
+				return
+			}
+
+			if !errors.Is(err, tc.want) {
+				t.Fatalf("error got:%v want:%v", err, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,627 @@
+package filter
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/ketion-so/go-notion/notion"
+)
+
+const dateLayout = "2006-01-02"
+
+// condition is a filter on a single property or timestamp, holding either a
+// *notion.PropertyFilter or a *notion.TimestampFilter.
+type condition struct {
+	value interface{}
+	check func() error
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+func (c *condition) MarshalJSON() ([]byte, error) {
+	return json.Marshal(c.value)
+}
+
+// Validate implements the Filter interface.
+func (c *condition) Validate() error {
+	if pf, ok := c.value.(*notion.PropertyFilter); ok && pf.Property == "" {
+		return ErrNoProperty
+	}
+
+	if c.check != nil {
+		return c.check()
+	}
+
+	return nil
+}
+
+func (c *condition) depth() int {
+	return 0
+}
+
+// target turns a condition on a property value into a filter.
+type target func(pc notion.PropertyCondition) *condition
+
+func propertyTarget(property string) target {
+	return func(pc notion.PropertyCondition) *condition {
+		return &condition{value: &notion.PropertyFilter{Property: property, PropertyCondition: pc}}
+	}
+}
+
+func (t target) text(slot func(f *notion.TextFilter) notion.PropertyCondition) *TextCondition {
+	return &TextCondition{func(f *notion.TextFilter) *condition { return t(slot(f)) }}
+}
+
+func (t target) number() *NumberCondition {
+	return &NumberCondition{func(f *notion.NumberFilter) *condition {
+		return t(notion.PropertyCondition{Number: f})
+	}}
+}
+
+func (t target) checkbox() *CheckboxCondition {
+	return &CheckboxCondition{func(f *notion.CheckboxFilter) *condition {
+		return t(notion.PropertyCondition{Checkbox: f})
+	}}
+}
+
+func (t target) date() *DateCondition {
+	return &DateCondition{func(f *notion.DateFilter) *condition {
+		return t(notion.PropertyCondition{Date: f})
+	}}
+}
+
+// TextCondition builds the filters of text properties.
+type TextCondition struct {
+	build func(f *notion.TextFilter) *condition
+}
+
+// Text filters a rich text property.
+func Text(property string) *TextCondition {
+	return propertyTarget(property).text(func(f *notion.TextFilter) notion.PropertyCondition {
+		return notion.PropertyCondition{Text: f}
+	})
+}
+
+// Title filters the title property.
+func Title(property string) *TextCondition {
+	return propertyTarget(property).text(func(f *notion.TextFilter) notion.PropertyCondition {
+		return notion.PropertyCondition{Title: f}
+	})
+}
+
+// URL filters an URL property.
+func URL(property string) *TextCondition {
+	return propertyTarget(property).text(func(f *notion.TextFilter) notion.PropertyCondition {
+		return notion.PropertyCondition{URL: f}
+	})
+}
+
+// Email filters an email property.
+func Email(property string) *TextCondition {
+	return propertyTarget(property).text(func(f *notion.TextFilter) notion.PropertyCondition {
+		return notion.PropertyCondition{Email: f}
+	})
+}
+
+// PhoneNumber filters a phone number property.
+func PhoneNumber(property string) *TextCondition {
+	return propertyTarget(property).text(func(f *notion.TextFilter) notion.PropertyCondition {
+		return notion.PropertyCondition{PhoneNumber: f}
+	})
+}
+
+// Equals matches the values equal to s.
+func (c *TextCondition) Equals(s string) Filter {
+	return c.build(&notion.TextFilter{Equals: &s})
+}
+
+// DoesNotEqual matches the values not equal to s.
+func (c *TextCondition) DoesNotEqual(s string) Filter {
+	return c.build(&notion.TextFilter{DoesNotEqual: &s})
+}
+
+// Contains matches the values containing s.
+func (c *TextCondition) Contains(s string) Filter {
+	return c.build(&notion.TextFilter{Contains: &s})
+}
+
+// DoesNotContain matches the values not containing s.
+func (c *TextCondition) DoesNotContain(s string) Filter {
+	return c.build(&notion.TextFilter{DoesNotContain: &s})
+}
+
+// StartsWith matches the values starting with s.
+func (c *TextCondition) StartsWith(s string) Filter {
+	return c.build(&notion.TextFilter{StartsWith: &s})
+}
+
+// EndsWith matches the values ending with s.
+func (c *TextCondition) EndsWith(s string) Filter {
+	return c.build(&notion.TextFilter{EndsWith: &s})
+}
+
+// IsEmpty matches the empty values.
+func (c *TextCondition) IsEmpty() Filter {
+	return c.build(&notion.TextFilter{IsEmpty: true})
+}
+
+// IsNotEmpty matches the values which are not empty.
+func (c *TextCondition) IsNotEmpty() Filter {
+	return c.build(&notion.TextFilter{IsNotEmpty: true})
+}
+
+// NumberCondition builds the filters of number properties.
+type NumberCondition struct {
+	build func(f *notion.NumberFilter) *condition
+}
+
+// Number filters a number property.
+func Number(property string) *NumberCondition {
+	return propertyTarget(property).number()
+}
+
+// Equals matches the values equal to n.
+func (c *NumberCondition) Equals(n float64) Filter {
+	return c.build(&notion.NumberFilter{Equals: &n})
+}
+
+// DoesNotEqual matches the values not equal to n.
+func (c *NumberCondition) DoesNotEqual(n float64) Filter {
+	return c.build(&notion.NumberFilter{DoesNotEqual: &n})
+}
+
+// GreaterThan matches the values greater than n.
+func (c *NumberCondition) GreaterThan(n float64) Filter {
+	return c.build(&notion.NumberFilter{GreaterThan: &n})
+}
+
+// LessThan matches the values less than n.
+func (c *NumberCondition) LessThan(n float64) Filter {
+	return c.build(&notion.NumberFilter{LessThan: &n})
+}
+
+// GreaterThanOrEqualTo matches the values greater than or equal to n.
+func (c *NumberCondition) GreaterThanOrEqualTo(n float64) Filter {
+	return c.build(&notion.NumberFilter{GreaterThanOrEqualTo: &n})
+}
+
+// LessThanOrEqualTo matches the values less than or equal to n.
+func (c *NumberCondition) LessThanOrEqualTo(n float64) Filter {
+	return c.build(&notion.NumberFilter{LessThanOrEqualTo: &n})
+}
+
+// IsEmpty matches the empty values.
+func (c *NumberCondition) IsEmpty() Filter {
+	return c.build(&notion.NumberFilter{IsEmpty: true})
+}
+
+// IsNotEmpty matches the values which are not empty.
+func (c *NumberCondition) IsNotEmpty() Filter {
+	return c.build(&notion.NumberFilter{IsNotEmpty: true})
+}
+
+// CheckboxCondition builds the filters of checkbox properties.
+type CheckboxCondition struct {
+	build func(f *notion.CheckboxFilter) *condition
+}
+
+// Checkbox filters a checkbox property.
+func Checkbox(property string) *CheckboxCondition {
+	return propertyTarget(property).checkbox()
+}
+
+// Equals matches the values equal to b.
+func (c *CheckboxCondition) Equals(b bool) Filter {
+	return c.build(&notion.CheckboxFilter{Equals: &b})
+}
+
+// DoesNotEqual matches the values not equal to b.
+func (c *CheckboxCondition) DoesNotEqual(b bool) Filter {
+	return c.build(&notion.CheckboxFilter{DoesNotEqual: &b})
+}
+
+// SelectCondition builds the filters of select properties.
+type SelectCondition struct {
+	build func(f *notion.SelectFilter) *condition
+}
+
+// Select filters a select property.
+func Select(property string) *SelectCondition {
+	return propertyTarget(property).selectCondition()
+}
+
+func (t target) selectCondition() *SelectCondition {
+	return &SelectCondition{func(f *notion.SelectFilter) *condition {
+		return t(notion.PropertyCondition{Select: f})
+	}}
+}
+
+// Equals matches the option named option.
+func (c *SelectCondition) Equals(option string) Filter {
+	return c.build(&notion.SelectFilter{Equals: &option})
+}
+
+// DoesNotEqual matches the options not named option.
+func (c *SelectCondition) DoesNotEqual(option string) Filter {
+	return c.build(&notion.SelectFilter{DoesNotEqual: &option})
+}
+
+// IsEmpty matches the empty values.
+func (c *SelectCondition) IsEmpty() Filter {
+	return c.build(&notion.SelectFilter{IsEmpty: true})
+}
+
+// IsNotEmpty matches the values which are not empty.
+func (c *SelectCondition) IsNotEmpty() Filter {
+	return c.build(&notion.SelectFilter{IsNotEmpty: true})
+}
+
+// MultiSelectCondition builds the filters of multi select properties.
+type MultiSelectCondition struct {
+	build func(f *notion.MultiSelectFilter) *condition
+}
+
+// MultiSelect filters a multi select property.
+func MultiSelect(property string) *MultiSelectCondition {
+	return propertyTarget(property).multiSelect()
+}
+
+func (t target) multiSelect() *MultiSelectCondition {
+	return &MultiSelectCondition{func(f *notion.MultiSelectFilter) *condition {
+		return t(notion.PropertyCondition{MultiSelect: f})
+	}}
+}
+
+// Contains matches the values containing the option.
+func (c *MultiSelectCondition) Contains(option string) Filter {
+	return c.build(&notion.MultiSelectFilter{Contains: &option})
+}
+
+// DoesNotContain matches the values not containing the option.
+func (c *MultiSelectCondition) DoesNotContain(option string) Filter {
+	return c.build(&notion.MultiSelectFilter{DoesNotContain: &option})
+}
+
+// IsEmpty matches the empty values.
+func (c *MultiSelectCondition) IsEmpty() Filter {
+	return c.build(&notion.MultiSelectFilter{IsEmpty: true})
+}
+
+// IsNotEmpty matches the values which are not empty.
+func (c *MultiSelectCondition) IsNotEmpty() Filter {
+	return c.build(&notion.MultiSelectFilter{IsNotEmpty: true})
+}
+
+// DateCondition builds the filters of date properties and timestamps.
+type DateCondition struct {
+	build func(f *notion.DateFilter) *condition
+}
+
+// Date filters a date property.
+func Date(property string) *DateCondition {
+	return propertyTarget(property).date()
+}
+
+// CreatedTime filters the pages by their creation time.
+func CreatedTime() *DateCondition {
+	return &DateCondition{func(f *notion.DateFilter) *condition {
+		return &condition{value: &notion.TimestampFilter{Timestamp: notion.CreatedTime, CreatedTime: f}}
+	}}
+}
+
+// LastEditedTime filters the pages by their last edition time.
+func LastEditedTime() *DateCondition {
+	return &DateCondition{func(f *notion.DateFilter) *condition {
+		return &condition{value: &notion.TimestampFilter{Timestamp: notion.LastEditedTime, LastEditedTime: f}}
+	}}
+}
+
+// dateCond returns a condition on an ISO 8601 date, e.g. "2021-05-10" or
+// "2021-05-10T12:00:00Z".
+func (c *DateCondition) dateCond(op, date string, f *notion.DateFilter) Filter {
+	cond := c.build(f)
+	cond.check = func() error {
+		if _, err := time.Parse(dateLayout, date); err == nil {
+			return nil
+		}
+		if _, err := time.Parse(time.RFC3339, date); err != nil {
+			return fmt.Errorf("%s: %q is not an ISO 8601 date", op, date)
+		}
+		return nil
+	}
+	return cond
+}
+
+// Equals matches the dates equal to date.
+func (c *DateCondition) Equals(date string) Filter {
+	return c.dateCond("equals", date, &notion.DateFilter{Equals: &date})
+}
+
+// Before matches the dates before date.
+func (c *DateCondition) Before(date string) Filter {
+	return c.dateCond("before", date, &notion.DateFilter{Before: &date})
+}
+
+// After matches the dates after date.
+func (c *DateCondition) After(date string) Filter {
+	return c.dateCond("after", date, &notion.DateFilter{After: &date})
+}
+
+// OnOrBefore matches the dates on or before date.
+func (c *DateCondition) OnOrBefore(date string) Filter {
+	return c.dateCond("on_or_before", date, &notion.DateFilter{OnOrBefore: &date})
+}
+
+// OnOrAfter matches the dates on or after date.
+func (c *DateCondition) OnOrAfter(date string) Filter {
+	return c.dateCond("on_or_after", date, &notion.DateFilter{OnOrAfter: &date})
+}
+
+// PastWeek matches the dates within the past week.
+func (c *DateCondition) PastWeek() Filter {
+	return c.build(&notion.DateFilter{PastWeek: &struct{}{}})
+}
+
+// PastMonth matches the dates within the past month.
+func (c *DateCondition) PastMonth() Filter {
+	return c.build(&notion.DateFilter{PastMonth: &struct{}{}})
+}
+
+// PastYear matches the dates within the past year.
+func (c *DateCondition) PastYear() Filter {
+	return c.build(&notion.DateFilter{PastYear: &struct{}{}})
+}
+
+// NextWeek matches the dates within the next week.
+func (c *DateCondition) NextWeek() Filter {
+	return c.build(&notion.DateFilter{NextWeek: &struct{}{}})
+}
+
+// NextMonth matches the dates within the next month.
+func (c *DateCondition) NextMonth() Filter {
+	return c.build(&notion.DateFilter{NextMonth: &struct{}{}})
+}
+
+// NextYear matches the dates within the next year.
+func (c *DateCondition) NextYear() Filter {
+	return c.build(&notion.DateFilter{NextYear: &struct{}{}})
+}
+
+// IsEmpty matches the empty values.
+func (c *DateCondition) IsEmpty() Filter {
+	return c.build(&notion.DateFilter{IsEmpty: true})
+}
+
+// IsNotEmpty matches the values which are not empty.
+func (c *DateCondition) IsNotEmpty() Filter {
+	return c.build(&notion.DateFilter{IsNotEmpty: true})
+}
+
+// ListCondition builds the filters of people and relation properties.
+type ListCondition struct {
+	contains       func(id string) *condition
+	doesNotContain func(id string) *condition
+	isEmpty        func() *condition
+	isNotEmpty     func() *condition
+}
+
+// People filters a people property, by user ID.
+func People(property string) *ListCondition {
+	return propertyTarget(property).people()
+}
+
+func (t target) people() *ListCondition {
+	return &ListCondition{
+		contains: func(id string) *condition {
+			return t(notion.PropertyCondition{People: &notion.PeopleFilter{Contains: &id}})
+		},
+		doesNotContain: func(id string) *condition {
+			return t(notion.PropertyCondition{People: &notion.PeopleFilter{DoesNotContain: &id}})
+		},
+		isEmpty: func() *condition {
+			return t(notion.PropertyCondition{People: &notion.PeopleFilter{IsEmpty: true}})
+		},
+		isNotEmpty: func() *condition {
+			return t(notion.PropertyCondition{People: &notion.PeopleFilter{IsNotEmpty: true}})
+		},
+	}
+}
+
+// Relation filters a relation property, by page ID.
+func Relation(property string) *ListCondition {
+	return propertyTarget(property).relation()
+}
+
+func (t target) relation() *ListCondition {
+	return &ListCondition{
+		contains: func(id string) *condition {
+			return t(notion.PropertyCondition{Relation: &notion.RelationFilter{Contains: &id}})
+		},
+		doesNotContain: func(id string) *condition {
+			return t(notion.PropertyCondition{Relation: &notion.RelationFilter{DoesNotContain: &id}})
+		},
+		isEmpty: func() *condition {
+			return t(notion.PropertyCondition{Relation: &notion.RelationFilter{IsEmpty: true}})
+		},
+		isNotEmpty: func() *condition {
+			return t(notion.PropertyCondition{Relation: &notion.RelationFilter{IsNotEmpty: true}})
+		},
+	}
+}
+
+// Contains matches the values containing the ID.
+func (c *ListCondition) Contains(id string) Filter { return c.contains(id) }
+
+// DoesNotContain matches the values not containing the ID.
+func (c *ListCondition) DoesNotContain(id string) Filter { return c.doesNotContain(id) }
+
+// IsEmpty matches the empty values.
+func (c *ListCondition) IsEmpty() Filter { return c.isEmpty() }
+
+// IsNotEmpty matches the values which are not empty.
+func (c *ListCondition) IsNotEmpty() Filter { return c.isNotEmpty() }
+
+// FilesCondition builds the filters of files properties.
+type FilesCondition struct {
+	build func(f *notion.FilesFilter) *condition
+}
+
+// Files filters a files property.
+func Files(property string) *FilesCondition {
+	return propertyTarget(property).files()
+}
+
+func (t target) files() *FilesCondition {
+	return &FilesCondition{func(f *notion.FilesFilter) *condition {
+		return t(notion.PropertyCondition{Files: f})
+	}}
+}
+
+// IsEmpty matches the empty values.
+func (c *FilesCondition) IsEmpty() Filter {
+	return c.build(&notion.FilesFilter{IsEmpty: true})
+}
+
+// IsNotEmpty matches the values which are not empty.
+func (c *FilesCondition) IsNotEmpty() Filter {
+	return c.build(&notion.FilesFilter{IsNotEmpty: true})
+}
+
+// FormulaCondition builds the filters of formula properties, according to
+// the type of the formula result.
+type FormulaCondition struct {
+	t target
+}
+
+// Formula filters a formula property.
+func Formula(property string) *FormulaCondition {
+	return &FormulaCondition{propertyTarget(property)}
+}
+
+func (c *FormulaCondition) formula(f *notion.FormulaFilter) *condition {
+	return c.t(notion.PropertyCondition{Formula: f})
+}
+
+// Text filters a formula returning text.
+func (c *FormulaCondition) Text() *TextCondition {
+	return &TextCondition{func(f *notion.TextFilter) *condition {
+		return c.formula(&notion.FormulaFilter{Text: f})
+	}}
+}
+
+// Checkbox filters a formula returning a boolean.
+func (c *FormulaCondition) Checkbox() *CheckboxCondition {
+	return &CheckboxCondition{func(f *notion.CheckboxFilter) *condition {
+		return c.formula(&notion.FormulaFilter{Checkbox: f})
+	}}
+}
+
+// Number filters a formula returning a number.
+func (c *FormulaCondition) Number() *NumberCondition {
+	return &NumberCondition{func(f *notion.NumberFilter) *condition {
+		return c.formula(&notion.FormulaFilter{Number: f})
+	}}
+}
+
+// Date filters a formula returning a date.
+func (c *FormulaCondition) Date() *DateCondition {
+	return &DateCondition{func(f *notion.DateFilter) *condition {
+		return c.formula(&notion.FormulaFilter{Date: f})
+	}}
+}
+
+// RollupCondition builds the filters of rollup properties.
+type RollupCondition struct {
+	t target
+}
+
+// Rollup filters a rollup property.
+func Rollup(property string) *RollupCondition {
+	return &RollupCondition{propertyTarget(property)}
+}
+
+func (c *RollupCondition) rollup(f *notion.RollupFilter) *condition {
+	return c.t(notion.PropertyCondition{Rollup: f})
+}
+
+// Any filters the rollups computing an array with an item matching the
+// condition.
+func (c *RollupCondition) Any() *ItemCondition {
+	return &ItemCondition{func(pc notion.PropertyCondition) *condition {
+		return c.rollup(&notion.RollupFilter{Any: &pc})
+	}}
+}
+
+// Every filters the rollups computing an array whose items all match the
+// condition.
+func (c *RollupCondition) Every() *ItemCondition {
+	return &ItemCondition{func(pc notion.PropertyCondition) *condition {
+		return c.rollup(&notion.RollupFilter{Every: &pc})
+	}}
+}
+
+// None filters the rollups computing an array with no item matching the
+// condition.
+func (c *RollupCondition) None() *ItemCondition {
+	return &ItemCondition{func(pc notion.PropertyCondition) *condition {
+		return c.rollup(&notion.RollupFilter{None: &pc})
+	}}
+}
+
+// Number filters the rollups computing a number.
+func (c *RollupCondition) Number() *NumberCondition {
+	return &NumberCondition{func(f *notion.NumberFilter) *condition {
+		return c.rollup(&notion.RollupFilter{Number: f})
+	}}
+}
+
+// Date filters the rollups computing a date.
+func (c *RollupCondition) Date() *DateCondition {
+	return &DateCondition{func(f *notion.DateFilter) *condition {
+		return c.rollup(&notion.RollupFilter{Date: f})
+	}}
+}
+
+// ItemCondition builds the condition on the items of a rollup, according to
+// the type of the rolled up property.
+type ItemCondition struct {
+	t target
+}
+
+// Text filters rich text items.
+func (c *ItemCondition) Text() *TextCondition {
+	return c.t.text(func(f *notion.TextFilter) notion.PropertyCondition {
+		return notion.PropertyCondition{Text: f}
+	})
+}
+
+// Title filters title items.
+func (c *ItemCondition) Title() *TextCondition {
+	return c.t.text(func(f *notion.TextFilter) notion.PropertyCondition {
+		return notion.PropertyCondition{Title: f}
+	})
+}
+
+// Number filters number items.
+func (c *ItemCondition) Number() *NumberCondition { return c.t.number() }
+
+// Checkbox filters checkbox items.
+func (c *ItemCondition) Checkbox() *CheckboxCondition { return c.t.checkbox() }
+
+// Select filters select items.
+func (c *ItemCondition) Select() *SelectCondition { return c.t.selectCondition() }
+
+// MultiSelect filters multi select items.
+func (c *ItemCondition) MultiSelect() *MultiSelectCondition { return c.t.multiSelect() }
+
+// Date filters date items.
+func (c *ItemCondition) Date() *DateCondition { return c.t.date() }
+
+// People filters people items.
+func (c *ItemCondition) People() *ListCondition { return c.t.people() }
+
+// Relation filters relation items.
+func (c *ItemCondition) Relation() *ListCondition { return c.t.relation() }
+
+// Files filters files items.
+func (c *ItemCondition) Files() *FilesCondition { return c.t.files() }
@@ -0,0 +1,112 @@
+package filter
+
+import (
+	"testing"
+)
+
+func TestCondition(t *testing.T) {
+	tcs := map[string]struct {
+		filter Filter
+		want   string
+	}{
+		"text": {
+			Text("Notes").DoesNotContain("draft"),
+			`{"property": "Notes", "text": {"does_not_contain": "draft"}}`,
+		},
+		"title": {
+			Title("Name").StartsWith("Go"),
+			`{"property": "Name", "title": {"starts_with": "Go"}}`,
+		},
+		"number zero": {
+			Number("Stock").Equals(0),
+			`{"property": "Stock", "number": {"equals": 0}}`,
+		},
+		"checkbox": {
+			Checkbox("Done").DoesNotEqual(true),
+			`{"property": "Done", "checkbox": {"does_not_equal": true}}`,
+		},
+		"select": {
+			Select("Status").IsEmpty(),
+			`{"property": "Status", "select": {"is_empty": true}}`,
+		},
+		"multi select": {
+			MultiSelect("Tags").Contains("urgent"),
+			`{"property": "Tags", "multi_select": {"contains": "urgent"}}`,
+		},
+		"date": {
+			Date("Due").OnOrAfter("2021-05-10"),
+			`{"property": "Due", "date": {"on_or_after": "2021-05-10"}}`,
+		},
+		"relative date": {
+			Date("Due").PastWeek(),
+			`{"property": "Due", "date": {"past_week": {}}}`,
+		},
+		"people": {
+			People("Assignee").Contains("d40e767c-d7af-4b18-a86d-55c61f1e39a4"),
+			`{"property": "Assignee", "people": {"contains": "d40e767c-d7af-4b18-a86d-55c61f1e39a4"}}`,
+		},
+		"relation": {
+			Relation("Project").IsNotEmpty(),
+			`{"property": "Project", "relation": {"is_not_empty": true}}`,
+		},
+		"files": {
+			Files("Photo").IsEmpty(),
+			`{"property": "Photo", "files": {"is_empty": true}}`,
+		},
+		"formula": {
+			Formula("Cost").Number().LessThanOrEqualTo(10),
+			`{"property": "Cost", "formula": {"number": {"less_than_or_equal_to": 10}}}`,
+		},
+		"formula checkbox false": {
+			Formula("Overdue").Checkbox().Equals(false),
+			`{"property": "Overdue", "formula": {"checkbox": {"equals": false}}}`,
+		},
+		"rollup any": {
+			Rollup("Tasks").Any().Checkbox().Equals(false),
+			`{"property": "Tasks", "rollup": {"any": {"checkbox": {"equals": false}}}}`,
+		},
+		"rollup every": {
+			Rollup("Tasks").Every().Select().Equals("Done"),
+			`{"property": "Tasks", "rollup": {"every": {"select": {"equals": "Done"}}}}`,
+		},
+		"rollup none": {
+			Rollup("Tasks").None().Text().Contains("blocked"),
+			`{"property": "Tasks", "rollup": {"none": {"text": {"contains": "blocked"}}}}`,
+		},
+		"rollup number": {
+			Rollup("Total").Number().GreaterThan(0),
+			`{"property": "Total", "rollup": {"number": {"greater_than": 0}}}`,
+		},
+		"created time": {
+			CreatedTime().After("2021-05-10"),
+			`{"timestamp": "created_time", "created_time": {"after": "2021-05-10"}}`,
+		},
+		"last edited time": {
+			LastEditedTime().PastWeek(),
+			`{"timestamp": "last_edited_time", "last_edited_time": {"past_week": {}}}`,
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			if err := tc.filter.Validate(); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			assertJSON(t, tc.filter, tc.want)
+		})
+	}
+}
+
+func TestDateCondition_invalid(t *testing.T) {
+	if err := Date("Due").Before("next friday").Validate(); err == nil {
+		t.Fatalf("expected error")
+	}
+
+	if err := Date("Due").Before("2021-05-10T12:00:00Z").Validate(); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+}
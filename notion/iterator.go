@@ -0,0 +1,402 @@
+package notion
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// maxPageSize is the largest page size accepted by the API.
+const maxPageSize = 100
+
+// IteratorOptions configures the pagination of an iterator.
+type IteratorOptions struct {
+	// PageSize is the number of items fetched per request, the API default
+	// when zero. It is capped to 100, the largest page size of the API.
+	PageSize int32
+
+	// MaxItems stops the iteration after this many items, no limit when zero.
+	MaxItems int
+}
+
+// fetchFunc fetches the page starting at cursor.
+type fetchFunc func(ctx context.Context, cursor string, pageSize int32) (*resultPage, error)
+
+// resultPage is a page of results fetched by a pager.
+type resultPage struct {
+	items      []interface{}
+	nextCursor string
+	hasMore    bool
+}
+
+// pager walks the pages of a list endpoint, one item at a time.
+type pager struct {
+	fetch fetchFunc
+	opts  IteratorOptions
+
+	started    bool
+	items      []interface{}
+	idx        int
+	skip       int
+	pageCursor string
+	nextCursor string
+	hasMore    bool
+	count      int
+	value      interface{}
+	err        error
+}
+
+// newPager returns a pager starting at cursor, skipping the first skip items
+// of that page.
+func newPager(fetch fetchFunc, cursor string, skip int, iopts *IteratorOptions) *pager {
+	p := &pager{fetch: fetch, nextCursor: cursor, skip: skip}
+	if iopts != nil {
+		p.opts = *iopts
+	}
+	return p
+}
+
+// next advances to the next item, fetching the next page when needed.
+func (p *pager) next(ctx context.Context) bool {
+	if p.err != nil || (p.opts.MaxItems > 0 && p.count >= p.opts.MaxItems) {
+		return false
+	}
+
+	for p.idx >= len(p.items) {
+		if p.started && !p.hasMore {
+			return false
+		}
+
+		if err := p.fetchPage(ctx); err != nil {
+			p.err = err
+			return false
+		}
+	}
+
+	p.value = p.items[p.idx]
+	p.idx++
+	p.count++
+	return true
+}
+
+func (p *pager) fetchPage(ctx context.Context) error {
+	cursor := p.nextCursor
+	rp, err := p.fetch(ctx, cursor, p.pageSize())
+	if err != nil {
+		return err
+	}
+
+	p.started = true
+	p.items = rp.items
+	// The page may be shorter than the items to skip when the page size has
+	// changed since the position has been saved.
+	p.idx = p.skip
+	if p.idx > len(p.items) {
+		p.idx = len(p.items)
+	}
+	p.skip -= p.idx
+	p.pageCursor = cursor
+	p.nextCursor = rp.nextCursor
+	p.hasMore = rp.hasMore && rp.nextCursor != ""
+	return nil
+}
+
+// pageSize returns the page size of the next request, capped to maxPageSize
+// and shrunk to the number of items left when MaxItems is set.
+func (p *pager) pageSize() int32 {
+	size := p.opts.PageSize
+	if size > maxPageSize {
+		size = maxPageSize
+	}
+	if p.opts.MaxItems <= 0 {
+		return size
+	}
+
+	left := p.opts.MaxItems - p.count + p.skip
+	if left <= maxPageSize && (size == 0 || int(size) > left) {
+		return int32(left)
+	}
+	return size
+}
+
+// position returns the start cursor of the page holding the next item and the
+// offset of the item within the page, or done when the pages are exhausted.
+func (p *pager) position() (cursor string, offset int, done bool) {
+	switch {
+	case p.started && p.idx < len(p.items):
+		return p.pageCursor, p.idx, false
+	case !p.started || p.hasMore:
+		return p.nextCursor, p.skip, false
+	default:
+		return "", 0, true
+	}
+}
+
+// cursor returns the start cursor of the page following the fetched ones.
+func (p *pager) cursor() string {
+	if !p.hasMore {
+		return ""
+	}
+	return p.nextCursor
+}
+
+// SearchIterator iterates over the results of a search.
+type SearchIterator struct {
+	req SearchRequest
+	p   *pager
+}
+
+// Iterator returns an iterator over all the results of the search, fetching
+// the pages lazily.
+func (s *SearchService) Iterator(sreq *SearchRequest, iopts *IteratorOptions, opts ...RequestOption) *SearchIterator {
+	req := SearchRequest{}
+	if sreq != nil {
+		req = *sreq
+	}
+
+	return s.iterator(req, req.StartCursor, 0, iopts, opts)
+}
+
+func (s *SearchService) iterator(sreq SearchRequest, cursor string, skip int, iopts *IteratorOptions, opts []RequestOption) *SearchIterator {
+	return &SearchIterator{
+		req: sreq,
+		p: newPager(func(ctx context.Context, cursor string, pageSize int32) (*resultPage, error) {
+			req := sreq
+			req.StartCursor = cursor
+			if pageSize > 0 {
+				req.PageSize = pageSize
+			}
+
+			results, err := s.Search(ctx, &req, opts...)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]interface{}, 0, len(results.Results))
+			for _, r := range results.Results {
+				items = append(items, r)
+			}
+			return &resultPage{items, results.NextCursor, results.HasMore}, nil
+		}, cursor, skip, iopts),
+	}
+}
+
+// Next advances the iterator and reports whether a value is available.
+func (it *SearchIterator) Next(ctx context.Context) bool {
+	return it.p.next(ctx)
+}
+
+// Value returns the current value.
+func (it *SearchIterator) Value() object.Object {
+	v, _ := it.p.value.(object.Object)
+	return v
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *SearchIterator) Err() error {
+	return it.p.err
+}
+
+// Cursor returns the start cursor of the page following the fetched ones, or
+// an empty string when there is none.
+func (it *SearchIterator) Cursor() string {
+	return it.p.cursor()
+}
+
+// All returns all the remaining values.
+func (it *SearchIterator) All(ctx context.Context) ([]object.Object, error) {
+	values := []object.Object{}
+	for it.Next(ctx) {
+		values = append(values, it.Value())
+	}
+	return values, it.Err()
+}
+
+// QueryIterator iterates over the pages matching a database query.
+type QueryIterator struct {
+	databaseID string
+	query      DatabaseQuery
+	p          *pager
+}
+
+// QueryIterator returns an iterator over all the pages matching the query,
+// fetching the result pages lazily.
+func (s *DatabasesService) QueryIterator(databaseID string, query *DatabaseQuery, iopts *IteratorOptions, opts ...RequestOption) *QueryIterator {
+	q := DatabaseQuery{}
+	if query != nil {
+		q = *query
+	}
+
+	return s.queryIterator(databaseID, q, q.StartCursor, 0, iopts, opts)
+}
+
+func (s *DatabasesService) queryIterator(databaseID string, query DatabaseQuery, cursor string, skip int, iopts *IteratorOptions, opts []RequestOption) *QueryIterator {
+	return &QueryIterator{
+		databaseID: databaseID,
+		query:      query,
+		p: newPager(func(ctx context.Context, cursor string, pageSize int32) (*resultPage, error) {
+			q := query
+			q.StartCursor = cursor
+			if pageSize > 0 {
+				q.PageSize = pageSize
+			}
+
+			results, err := s.Query(ctx, databaseID, &q, opts...)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]interface{}, 0, len(results.Results))
+			for _, r := range results.Results {
+				p, ok := r.(*Page)
+				if !ok {
+					return nil, fmt.Errorf("database %s query returned %T, not a page", databaseID, r)
+				}
+				items = append(items, p)
+			}
+			return &resultPage{items, results.NextCursor, results.HasMore}, nil
+		}, cursor, skip, iopts),
+	}
+}
+
+// Next advances the iterator and reports whether a value is available.
+func (it *QueryIterator) Next(ctx context.Context) bool {
+	return it.p.next(ctx)
+}
+
+// Value returns the current value.
+func (it *QueryIterator) Value() *Page {
+	v, _ := it.p.value.(*Page)
+	return v
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *QueryIterator) Err() error {
+	return it.p.err
+}
+
+// Cursor returns the start cursor of the page following the fetched ones, or
+// an empty string when there is none.
+func (it *QueryIterator) Cursor() string {
+	return it.p.cursor()
+}
+
+// All returns all the remaining values.
+func (it *QueryIterator) All(ctx context.Context) ([]*Page, error) {
+	values := []*Page{}
+	for it.Next(ctx) {
+		values = append(values, it.Value())
+	}
+	return values, it.Err()
+}
+
+// BlockIterator iterates over the children of a block.
+type BlockIterator struct {
+	p *pager
+}
+
+// ChildrenIterator returns an iterator over all the children of the block,
+// fetching the pages lazily.
+func (s *BlocksService) ChildrenIterator(blockID string, iopts *IteratorOptions, opts ...RequestOption) *BlockIterator {
+	return &BlockIterator{
+		p: newPager(func(ctx context.Context, cursor string, pageSize int32) (*resultPage, error) {
+			results, err := s.ListChildren(ctx, blockID, &ListOptions{StartCursor: cursor, PageSize: pageSize}, opts...)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]interface{}, 0, len(results.Results))
+			for _, r := range results.Results {
+				items = append(items, r)
+			}
+			return &resultPage{items, results.NextCursor, results.HasMore}, nil
+		}, "", 0, iopts),
+	}
+}
+
+// Next advances the iterator and reports whether a value is available.
+func (it *BlockIterator) Next(ctx context.Context) bool {
+	return it.p.next(ctx)
+}
+
+// Value returns the current value.
+func (it *BlockIterator) Value() Block {
+	v, _ := it.p.value.(Block)
+	return v
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *BlockIterator) Err() error {
+	return it.p.err
+}
+
+// Cursor returns the start cursor of the page following the fetched ones, or
+// an empty string when there is none.
+func (it *BlockIterator) Cursor() string {
+	return it.p.cursor()
+}
+
+// All returns all the remaining values.
+func (it *BlockIterator) All(ctx context.Context) ([]Block, error) {
+	values := []Block{}
+	for it.Next(ctx) {
+		values = append(values, it.Value())
+	}
+	return values, it.Err()
+}
+
+// UserIterator iterates over the users of a workspace.
+type UserIterator struct {
+	p *pager
+}
+
+// Iterator returns an iterator over all the users of the workspace, fetching
+// the pages lazily.
+func (s *UsersService) Iterator(iopts *IteratorOptions, opts ...RequestOption) *UserIterator {
+	return &UserIterator{
+		p: newPager(func(ctx context.Context, cursor string, pageSize int32) (*resultPage, error) {
+			results, err := s.List(ctx, &ListOptions{StartCursor: cursor, PageSize: pageSize}, opts...)
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]interface{}, 0, len(results.Results))
+			for i := range results.Results {
+				items = append(items, &results.Results[i])
+			}
+			return &resultPage{items, results.NextCursor, results.HasMore}, nil
+		}, "", 0, iopts),
+	}
+}
+
+// Next advances the iterator and reports whether a value is available.
+func (it *UserIterator) Next(ctx context.Context) bool {
+	return it.p.next(ctx)
+}
+
+// Value returns the current value.
+func (it *UserIterator) Value() *User {
+	v, _ := it.p.value.(*User)
+	return v
+}
+
+// Err returns the error which stopped the iteration, if any.
+func (it *UserIterator) Err() error {
+	return it.p.err
+}
+
+// Cursor returns the start cursor of the page following the fetched ones, or
+// an empty string when there is none.
+func (it *UserIterator) Cursor() string {
+	return it.p.cursor()
+}
+
+// All returns all the remaining values.
+func (it *UserIterator) All(ctx context.Context) ([]*User, error) {
+	values := []*User{}
+	for it.Next(ctx) {
+		values = append(values, it.Value())
+	}
+	return values, it.Err()
+}
@@ -0,0 +1,198 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+// pagedHandler serves the items in pages of the requested size, using the index
+// of the first item of a page as its cursor.
+func pagedHandler(t *testing.T, items []string, mu *sync.Mutex, requests *[]string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cursor, size := r.URL.Query().Get("start_cursor"), r.URL.Query().Get("page_size")
+		if r.Method == http.MethodPost {
+			var body struct {
+				StartCursor string `json:"start_cursor"`
+				PageSize    int32  `json:"page_size"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+			cursor, size = body.StartCursor, fmt.Sprint(body.PageSize)
+		}
+
+		mu.Lock()
+		*requests = append(*requests, fmt.Sprintf("%s/%s", cursor, size))
+		mu.Unlock()
+
+		start, n := 0, 100
+		fmt.Sscan(cursor, &start)
+		fmt.Sscan(size, &n)
+		if n <= 0 {
+			n = 100
+		}
+		end := start + n
+		if end > len(items) {
+			end = len(items)
+		}
+
+		next := "null"
+		if end < len(items) {
+			next = fmt.Sprintf(`"%d"`, end)
+		}
+
+		fmt.Fprintf(w, `{"object":"list","results":[%s],"next_cursor":%s,"has_more":%t}`,
+			strings.Join(items[start:end], ","), next, end < len(items))
+	}
+}
+
+func TestUserIterator(t *testing.T) {
+	tcs := map[string]struct {
+		opts         *IteratorOptions
+		wantIDs      []string
+		wantRequests []string
+	}{
+		"all": {
+			&IteratorOptions{PageSize: 2},
+			[]string{"u0", "u1", "u2", "u3", "u4"},
+			[]string{"/2", "2/2", "4/2"},
+		},
+		"max items": {
+			&IteratorOptions{PageSize: 2, MaxItems: 3},
+			[]string{"u0", "u1", "u2"},
+			[]string{"/2", "2/1"},
+		},
+		"default": {
+			nil,
+			[]string{"u0", "u1", "u2", "u3", "u4"},
+			[]string{"/"},
+		},
+		"page size capped": {
+			&IteratorOptions{PageSize: 500},
+			[]string{"u0", "u1", "u2", "u3", "u4"},
+			[]string{"/100"},
+		},
+		"page size capped with max items": {
+			&IteratorOptions{PageSize: 500, MaxItems: 200},
+			[]string{"u0", "u1", "u2", "u3", "u4"},
+			[]string{"/100"},
+		},
+	}
+
+	users := []string{}
+	for i := 0; i < 5; i++ {
+		users = append(users, getUserJSON(fmt.Sprintf("u%d", i)))
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			var mu sync.Mutex
+			requests := []string{}
+			mux.HandleFunc("/"+usersPath, pagedHandler(t, users, &mu, &requests))
+
+			got, err := client.Users.Iterator(tc.opts).All(context.Background())
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			ids := []string{}
+			for _, u := range got {
+				ids = append(ids, u.ID)
+			}
+
+			if diff := cmp.Diff(ids, tc.wantIDs); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+
+			if diff := cmp.Diff(requests, tc.wantRequests); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestQueryIterator(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	requests := []string{}
+	mux.HandleFunc(fmt.Sprintf("/%s/db/query", databasesPath), pagedHandler(t, pageItemsJSON(3), &mu, &requests))
+
+	query := &DatabaseQuery{}
+	it := client.Databases.QueryIterator("db", query, &IteratorOptions{PageSize: 2})
+
+	ids := []string{}
+	for it.Next(context.Background()) {
+		ids = append(ids, it.Value().ID)
+		if len(ids) == 2 && it.Cursor() != "2" {
+			t.Fatalf("cursor got:%s want:2", it.Cursor())
+		}
+	}
+
+	if err := it.Err(); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(ids, []string{"p0", "p1", "p2"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if it.Cursor() != "" {
+		t.Fatalf("cursor of exhausted iterator got:%s", it.Cursor())
+	}
+
+	if query.StartCursor != "" || query.PageSize != 0 {
+		t.Fatalf("query of the caller has been modified: %+v", query)
+	}
+}
+
+func TestQueryIterator_notPage(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/db/query", databasesPath), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"object":"list","results":[%s],"next_cursor":null,"has_more":false}`, getDatabaseSON())
+	})
+
+	pages, err := client.Databases.QueryIterator("db", nil, nil).All(context.Background())
+	if err == nil {
+		t.Fatalf("expected error got pages:%v", pages)
+	}
+}
+
+func TestBlockIterator_error(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/b/children", blocksPath), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"object":"error","status":404,"code":"object_not_found","message":"not found"}`)
+	})
+
+	it := client.Blocks.ChildrenIterator("b", nil)
+	if it.Next(context.Background()) {
+		t.Fatalf("iterator advanced on error")
+	}
+
+	if !IsNotFound(it.Err()) {
+		t.Fatalf("expected not found got:%v", it.Err())
+	}
+
+	if it.Next(context.Background()) {
+		t.Fatalf("iterator advanced after error")
+	}
+}
@@ -0,0 +1,179 @@
+package notion
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	defaultLogBodyLimit = 4096
+	redacted            = "[REDACTED]"
+)
+
+// Logger receives a structured entry for every request sent to the API.
+type Logger interface {
+	Log(ctx context.Context, entry *LogEntry)
+}
+
+// LoggerFunc is an adapter to use ordinary functions as Logger.
+type LoggerFunc func(ctx context.Context, entry *LogEntry)
+
+// Log implements the Logger interface.
+func (f LoggerFunc) Log(ctx context.Context, entry *LogEntry) {
+	f(ctx, entry)
+}
+
+// LogEntry describes a single request sent to the API.
+//
+// The bearer token is always redacted from headers and bodies.
+type LogEntry struct {
+	Method string
+	Path   string
+	// Status is the HTTP status code, zero when no response was received.
+	Status          int
+	Latency         time.Duration
+	RequestHeader   http.Header
+	RateLimitHeader http.Header
+	// RequestBody and ResponseBody are only set when bodies are logged,
+	// and are cut to the configured size.
+	RequestBody  []byte
+	ResponseBody []byte
+	Err          error
+}
+
+// LogOption configures the request logging.
+type LogOption func(cfg *logConfig)
+
+type logConfig struct {
+	bodies    bool
+	bodyLimit int
+}
+
+// LogBodies enables logging of request and response bodies, cut to maxSize
+// bytes. A zero or negative maxSize uses a default of 4KB.
+func LogBodies(maxSize int) LogOption {
+	return func(cfg *logConfig) {
+		if maxSize <= 0 {
+			maxSize = defaultLogBodyLimit
+		}
+		cfg.bodies = true
+		cfg.bodyLimit = maxSize
+	}
+}
+
+// WithLogger logs every request sent to the API to the logger.
+func WithLogger(logger Logger, opts ...LogOption) ClientOption {
+	return func(c *Client) {
+		cfg := &logConfig{}
+		for _, opt := range opts {
+			opt(cfg)
+		}
+		c.logger = loggingMiddleware(logger, cfg)
+	}
+}
+
+func loggingMiddleware(logger Logger, cfg *logConfig) Middleware {
+	return func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, call *Call) (*http.Response, error) {
+			token := bearerToken(call.Header)
+			entry := &LogEntry{
+				Method:        call.Method,
+				Path:          call.Path,
+				RequestHeader: redactHeader(call.Header),
+			}
+
+			if cfg.bodies {
+				entry.RequestBody = truncate(redactBody(call.Body, token), cfg.bodyLimit)
+			}
+
+			start := time.Now()
+			resp, err := next.Do(ctx, call)
+			entry.Latency = time.Since(start)
+			entry.Err = err
+
+			if resp != nil {
+				entry.Status = resp.StatusCode
+				entry.RateLimitHeader = rateLimitHeader(resp.Header)
+
+				var apiErr *Error
+				switch {
+				case !cfg.bodies:
+				case errors.As(err, &apiErr):
+					entry.ResponseBody = truncate(redactBody(apiErr.Body, token), cfg.bodyLimit)
+				case err == nil:
+					// Read a bit more than the limit so that a token spanning
+					// the cut is still redacted.
+					head, err := peekBody(resp, cfg.bodyLimit+len(token))
+					if err != nil {
+						return nil, err
+					}
+					entry.ResponseBody = truncate(redactBody(head, token), cfg.bodyLimit)
+				}
+			}
+
+			logger.Log(ctx, entry)
+			return resp, err
+		})
+	}
+}
+
+// peekBody reads up to limit bytes of the response body without consuming them.
+func peekBody(resp *http.Response, limit int) ([]byte, error) {
+	head, err := io.ReadAll(io.LimitReader(resp.Body, int64(limit)))
+	if err != nil {
+		resp.Body.Close()
+		return nil, err
+	}
+
+	resp.Body = &multiReadCloser{
+		Reader: io.MultiReader(bytes.NewReader(head), resp.Body),
+		Closer: resp.Body,
+	}
+	return head, nil
+}
+
+type multiReadCloser struct {
+	io.Reader
+	io.Closer
+}
+
+func bearerToken(h http.Header) string {
+	return strings.TrimPrefix(h.Get("Authorization"), "Bearer ")
+}
+
+func redactHeader(h http.Header) http.Header {
+	out := h.Clone()
+	if out.Get("Authorization") != "" {
+		out.Set("Authorization", "Bearer "+redacted)
+	}
+	return out
+}
+
+func redactBody(b []byte, token string) []byte {
+	if token == "" || b == nil {
+		return b
+	}
+	return bytes.ReplaceAll(b, []byte(token), []byte(redacted))
+}
+
+func truncate(b []byte, limit int) []byte {
+	if len(b) > limit {
+		b = b[:limit]
+	}
+	return append([]byte(nil), b...)
+}
+
+func rateLimitHeader(h http.Header) http.Header {
+	out := http.Header{}
+	for _, key := range []string{rateLimitLimitHeader, rateLimitRemainingHeader, rateLimitResetHeader} {
+		if v := h.Get(key); v != "" {
+			out.Set(key, v)
+		}
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package notion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+func TestWithLogger(t *testing.T) {
+	tcs := map[string]struct {
+		opts             []LogOption
+		wantRequestBody  string
+		wantResponseBody string
+	}{
+		"without bodies": {
+			nil,
+			"",
+			"",
+		},
+		"with bodies": {
+			[]LogOption{LogBodies(30)},
+			`{"query":"[REDACTED]","sort":n`,
+			`{"object": "list", "results": `,
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			var entries []*LogEntry
+			logger := LoggerFunc(func(ctx context.Context, entry *LogEntry) {
+				entries = append(entries, entry)
+			})
+
+			client, mux, _, teardown := setup(WithLogger(logger, tc.opts...))
+			defer teardown()
+
+			mux.HandleFunc("/"+searchPath, func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Authorization"); got != "Bearer "+testAccessKey {
+					t.Fatalf("authorization header modified got:%s", got)
+				}
+
+				addHeader(w)
+				fmt.Fprint(w, `{"object": "list", "results": [], "has_more": false}`)
+			})
+
+			got, err := client.Search.Search(context.Background(), &SearchRequest{Query: testAccessKey})
+			if err != nil {
+				t.Fatalf("failed: %v", err)
+			}
+
+			if got.Object != "list" {
+				t.Fatalf("response body has not been preserved got:%+v", got)
+			}
+
+			if len(entries) != 1 {
+				t.Fatalf("log entries got:%d want:1", len(entries))
+			}
+
+			e := entries[0]
+			if e.Method != http.MethodPost || e.Path != searchPath || e.Status != http.StatusOK {
+				t.Fatalf("unexpected entry: %+v", e)
+			}
+
+			if got := e.RequestHeader.Get("Authorization"); strings.Contains(got, testAccessKey) {
+				t.Fatalf("authorization header not redacted got:%s", got)
+			}
+
+			if got := e.RateLimitHeader.Get(rateLimitRemainingHeader); got != "99" {
+				t.Fatalf("rate limit header not logged got:%s", got)
+			}
+
+			if string(e.RequestBody) != tc.wantRequestBody {
+				t.Fatalf("request body got:%s want:%s", e.RequestBody, tc.wantRequestBody)
+			}
+
+			if string(e.ResponseBody) != tc.wantResponseBody {
+				t.Fatalf("response body got:%s want:%s", e.ResponseBody, tc.wantResponseBody)
+			}
+		})
+	}
+}
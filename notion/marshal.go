@@ -0,0 +1,710 @@
+package notion
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+const (
+	tagName    = "notion"
+	dateLayout = "2006-01-02"
+)
+
+// PropertyMarshaler is implemented by the types which encode themselves into
+// a property value for MarshalProperties.
+type PropertyMarshaler interface {
+	MarshalNotionProperty() (PropertyValue, error)
+}
+
+// PropertyUnmarshaler is implemented by the types which decode themselves from
+// a property value for UnmarshalPage.
+type PropertyUnmarshaler interface {
+	UnmarshalNotionProperty(v PropertyValue) error
+}
+
+var (
+	timeType        = reflect.TypeOf(time.Time{})
+	marshalerType   = reflect.TypeOf((*PropertyMarshaler)(nil)).Elem()
+	unmarshalerType = reflect.TypeOf((*PropertyUnmarshaler)(nil)).Elem()
+)
+
+// field is a struct field mapped to a page property, or to an attribute of the
+// page itself when name is empty.
+type field struct {
+	index     []int
+	goName    string
+	name      string
+	typ       object.PropertyType
+	omitempty bool
+}
+
+// isMeta reports whether the field holds an attribute of the page, e.g. its
+// ID, rather than a property.
+func (f *field) isMeta() bool {
+	return f.name == ""
+}
+
+// structFields returns the fields of the struct type tagged with the notion
+// key, e.g.
+//
+//	Due  time.Time `notion:"Due,date"`
+//	Tags []string  `notion:"Tags,multi_select,omitempty"`
+//	ID   string    `notion:",id"`
+//
+// A tag without name maps the field to the page ID, creation time or last
+// edition time.
+func structFields(t reflect.Type) ([]*field, error) {
+	fields := []*field{}
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag, tagged := sf.Tag.Lookup(tagName)
+
+		if sf.Anonymous && !tagged && sf.Type.Kind() == reflect.Struct {
+			embedded, err := structFields(sf.Type)
+			if err != nil {
+				return nil, err
+			}
+			for _, f := range embedded {
+				f.index = append([]int{i}, f.index...)
+				fields = append(fields, f)
+			}
+			continue
+		}
+
+		if !tagged || tag == "-" || sf.PkgPath != "" {
+			continue
+		}
+
+		parts := strings.Split(tag, ",")
+		f := &field{index: []int{i}, goName: sf.Name, name: parts[0]}
+		for _, opt := range parts[1:] {
+			if opt == "omitempty" {
+				f.omitempty = true
+			} else if opt != "" {
+				f.typ = object.PropertyType(opt)
+			}
+		}
+
+		if f.isMeta() {
+			switch f.typ {
+			case "id", object.CreatedTimePropertyType, object.LastEditedTimePropertyType:
+			default:
+				return nil, fmt.Errorf("%s: tag without property name must be id, created_time or last_edited_time", sf.Name)
+			}
+		}
+
+		fields = append(fields, f)
+	}
+
+	return fields, nil
+}
+
+// UnmarshalPage decodes the properties of the page into the struct pointed to
+// by v, according to the notion tags of its fields.
+//
+// Fields are decoded from the property named in their tag. The property type
+// of the tag, when set, must match the type of the page property. Text, URL,
+// email and phone number properties decode into strings, numbers into any
+// numeric type, checkboxes into bools, selects into the option name, multi
+// selects into the option names, dates into time.Time or strings, people and
+// relations into IDs, and files into []File, or into their URLs when they are
+// all external files. Pointer fields are set to nil when the property is empty,
+// and fields are set to their zero value when the page doesn't have the
+// property. Types implementing PropertyUnmarshaler decode themselves.
+func UnmarshalPage(page *Page, v interface{}) error {
+	if page == nil {
+		return errors.New("cannot unmarshal nil page")
+	}
+
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("unmarshal target must be a non-nil pointer to a struct, got %T", v)
+	}
+	rv = rv.Elem()
+
+	fields, err := structFields(rv.Type())
+	if err != nil {
+		return err
+	}
+
+	for _, f := range fields {
+		fv := rv.FieldByIndex(f.index)
+
+		if f.isMeta() {
+			raw := page.ID
+			switch f.typ {
+			case object.CreatedTimePropertyType:
+				raw = page.CreatedTime
+			case object.LastEditedTimePropertyType:
+				raw = page.LastEditedTime
+			}
+			if err := setValue(fv, raw == "", []interface{}{}, canonical(raw, f.typ)); err != nil {
+				return fmt.Errorf("%s: %w", f.goName, err)
+			}
+			continue
+		}
+
+		pv, ok := page.Properties[f.name]
+		if !ok || pv == nil {
+			fv.Set(reflect.Zero(fv.Type()))
+			continue
+		}
+
+		if f.typ != "" && pv.GetType() != f.typ {
+			return fmt.Errorf("%s: %w", f.goName, &PropertyTypeError{Property: f.name, Want: f.typ, Got: pv.GetType()})
+		}
+
+		if err := unmarshalProperty(fv, pv); err != nil {
+			return fmt.Errorf("%s: %w", f.goName, err)
+		}
+	}
+
+	return nil
+}
+
+func canonical(raw string, typ object.PropertyType) interface{} {
+	if typ == "id" {
+		return raw
+	}
+	return Date{Start: raw}
+}
+
+func unmarshalProperty(fv reflect.Value, pv PropertyValue) error {
+	if fv.CanAddr() && fv.Addr().Type().Implements(unmarshalerType) {
+		if fv.Kind() == reflect.Ptr && fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		return fv.Addr().Interface().(PropertyUnmarshaler).UnmarshalNotionProperty(pv)
+	}
+	if fv.Kind() == reflect.Ptr && fv.Type().Implements(unmarshalerType) {
+		if fv.IsNil() {
+			fv.Set(reflect.New(fv.Type().Elem()))
+		}
+		return fv.Interface().(PropertyUnmarshaler).UnmarshalNotionProperty(pv)
+	}
+
+	switch pv := pv.(type) {
+	case *TitlePropertyValue:
+		return setValue(fv, len(pv.Title) == 0, []interface{}{pv.Title}, plainText(pv.Title))
+	case *TextPropertyValue:
+		return setValue(fv, len(pv.Text) == 0, []interface{}{pv.Text}, plainText(pv.Text))
+	case *NumberPropertyValue:
+		if pv.Number == nil {
+			return setValue(fv, true, nil, nil)
+		}
+		return setValue(fv, false, nil, *pv.Number)
+	case *CheckboxPropertyValue:
+		return setValue(fv, false, nil, pv.Checkbox)
+	case *SelectPropertyValue:
+		if pv.Select == nil {
+			return setValue(fv, true, nil, nil)
+		}
+		return setValue(fv, false, []interface{}{pv.Select, *pv.Select}, pv.Select.Name)
+	case *MultiSelectPropertyValue:
+		names := []string{}
+		for _, o := range pv.MultiSelect {
+			names = append(names, o.Name)
+		}
+		return setValue(fv, false, []interface{}{pv.MultiSelect}, names)
+	case *DatePropertyValue:
+		if pv.Date == nil {
+			return setValue(fv, true, nil, nil)
+		}
+		return setValue(fv, false, []interface{}{pv.Date}, *pv.Date)
+	case *PeoplePropertyValue:
+		ids := []string{}
+		for _, u := range pv.People {
+			ids = append(ids, u.ID)
+		}
+		return setValue(fv, false, []interface{}{pv.People}, ids)
+	case *RelationPropertyValue:
+		ids := []string{}
+		for _, r := range pv.Relation {
+			ids = append(ids, r.ID)
+		}
+		return setValue(fv, false, []interface{}{pv.Relation}, ids)
+	case *FilesPropertyValue:
+		ft := fv.Type()
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+		keepFiles := reflect.TypeOf(pv.Files).AssignableTo(ft)
+
+		urls := []string{}
+		for _, f := range pv.Files {
+			switch {
+			case f.File != nil:
+				// The URL of a file hosted by Notion expires, it would be
+				// written back as a broken external file.
+				if !keepFiles {
+					return fmt.Errorf("file %q is hosted by Notion and must be decoded into []File, not %s", f.Name, fv.Type())
+				}
+				urls = append(urls, f.File.URL)
+			case f.External != nil:
+				urls = append(urls, f.External.URL)
+			}
+		}
+		return setValue(fv, false, []interface{}{pv.Files}, urls)
+	case *URLPropertyValue:
+		return setValue(fv, pv.URL == nil, nil, stringValue(pv.URL))
+	case *EmailPropertyValue:
+		return setValue(fv, pv.Email == nil, nil, stringValue(pv.Email))
+	case *PhoneNumberPropertyValue:
+		return setValue(fv, pv.PhoneNumber == nil, nil, stringValue(pv.PhoneNumber))
+	case *FormulaPropertyValue:
+		if pv.Formula == nil {
+			return setValue(fv, true, nil, nil)
+		}
+		switch f := pv.Formula; f.Type {
+		case "string":
+			return setValue(fv, f.String == nil, nil, stringValue(f.String))
+		case "number":
+			if f.Number == nil {
+				return setValue(fv, true, nil, nil)
+			}
+			return setValue(fv, false, nil, *f.Number)
+		case "boolean":
+			if f.Boolean == nil {
+				return setValue(fv, true, nil, nil)
+			}
+			return setValue(fv, false, nil, *f.Boolean)
+		case "date":
+			if f.Date == nil {
+				return setValue(fv, true, nil, nil)
+			}
+			return setValue(fv, false, []interface{}{f.Date}, *f.Date)
+		default:
+			return fmt.Errorf("unsupported formula result type %q", f.Type)
+		}
+	case *RollupPropertyValue:
+		if pv.Rollup == nil {
+			return setValue(fv, true, nil, nil)
+		}
+		switch r := pv.Rollup; r.Type {
+		case "number":
+			if r.Number == nil {
+				return setValue(fv, true, nil, nil)
+			}
+			return setValue(fv, false, nil, *r.Number)
+		case "date":
+			if r.Date == nil {
+				return setValue(fv, true, nil, nil)
+			}
+			return setValue(fv, false, []interface{}{r.Date}, *r.Date)
+		default:
+			return setValue(fv, false, nil, r.Array)
+		}
+	case *CreatedTimePropertyValue:
+		return setValue(fv, pv.CreatedTime == "", nil, Date{Start: pv.CreatedTime})
+	case *LastEditedTimePropertyValue:
+		return setValue(fv, pv.LastEditedTime == "", nil, Date{Start: pv.LastEditedTime})
+	case *CreatedByPropertyValue:
+		if pv.CreatedBy == nil {
+			return setValue(fv, true, nil, nil)
+		}
+		return setValue(fv, false, []interface{}{pv.CreatedBy, *pv.CreatedBy}, pv.CreatedBy.ID)
+	case *LastEditedByPropertyValue:
+		if pv.LastEditedBy == nil {
+			return setValue(fv, true, nil, nil)
+		}
+		return setValue(fv, false, []interface{}{pv.LastEditedBy, *pv.LastEditedBy}, pv.LastEditedBy.ID)
+	default:
+		return fmt.Errorf("unsupported property value %T", pv)
+	}
+}
+
+// setValue sets the field to the first candidate assignable to it, or else
+// converts the canonical value of the property, e.g. its text or its number,
+// into the field. Pointer fields are set to nil when the property is empty.
+func setValue(fv reflect.Value, empty bool, candidates []interface{}, value interface{}) error {
+	for _, c := range candidates {
+		if reflect.TypeOf(c).AssignableTo(fv.Type()) {
+			fv.Set(reflect.ValueOf(c))
+			return nil
+		}
+	}
+
+	if fv.Kind() == reflect.Ptr {
+		if empty {
+			fv.Set(reflect.Zero(fv.Type()))
+			return nil
+		}
+		n := reflect.New(fv.Type().Elem())
+		if err := setValue(n.Elem(), false, candidates, value); err != nil {
+			return err
+		}
+		fv.Set(n)
+		return nil
+	}
+
+	if empty || value == nil {
+		fv.Set(reflect.Zero(fv.Type()))
+		return nil
+	}
+
+	if reflect.TypeOf(value).AssignableTo(fv.Type()) {
+		fv.Set(reflect.ValueOf(value))
+		return nil
+	}
+
+	switch value := value.(type) {
+	case string:
+		if fv.Kind() == reflect.String {
+			fv.SetString(value)
+			return nil
+		}
+	case bool:
+		if fv.Kind() == reflect.Bool {
+			fv.SetBool(value)
+			return nil
+		}
+	case float64:
+		switch fv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			fv.SetFloat(value)
+			return nil
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n := int64(value)
+			if float64(n) != value || fv.OverflowInt(n) {
+				return fmt.Errorf("number %v does not fit in %s", value, fv.Type())
+			}
+			fv.SetInt(n)
+			return nil
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			n := uint64(value)
+			if value < 0 || float64(n) != value || fv.OverflowUint(n) {
+				return fmt.Errorf("number %v does not fit in %s", value, fv.Type())
+			}
+			fv.SetUint(n)
+			return nil
+		}
+	case Date:
+		switch {
+		case fv.Type() == timeType:
+			t, err := parseDate(value.Start)
+			if err != nil {
+				return err
+			}
+			fv.Set(reflect.ValueOf(t))
+			return nil
+		case fv.Kind() == reflect.String:
+			fv.SetString(value.Start)
+			return nil
+		}
+	case []string:
+		if fv.Kind() == reflect.Slice && fv.Type().Elem().Kind() == reflect.String {
+			s := reflect.MakeSlice(fv.Type(), len(value), len(value))
+			for i, v := range value {
+				s.Index(i).SetString(v)
+			}
+			fv.Set(s)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("cannot unmarshal %T into %s", value, fv.Type())
+}
+
+// parseDate parses an ISO 8601 date or date time.
+func parseDate(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Parse(dateLayout, s)
+}
+
+// formatDate formats a time as a date when it is midnight UTC, or else as a
+// date time.
+func formatDate(t time.Time) string {
+	if _, offset := t.Zone(); offset == 0 && t.Hour() == 0 && t.Minute() == 0 && t.Second() == 0 && t.Nanosecond() == 0 {
+		return t.Format(dateLayout)
+	}
+	return t.Format(time.RFC3339)
+}
+
+// MarshalProperties encodes the struct v, or the struct pointed to by v, into
+// property values according to the notion tags of its fields, e.g. to create
+// or update a page.
+//
+// The property type must be set in the tag, unless the field implements
+// PropertyMarshaler. Files encode unchanged from []File, or as external files
+// from URLs. Nil pointers and zero times encode into empty properties,
+// and fields tagged omitempty are skipped when they hold their zero value.
+// Computed properties, such as formulas and rollups, and the page attributes
+// are never encoded.
+func MarshalProperties(v interface{}) (map[string]PropertyValue, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil, errors.New("cannot marshal nil pointer")
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("marshal source must be a struct, got %T", v)
+	}
+
+	fields, err := structFields(rv.Type())
+	if err != nil {
+		return nil, err
+	}
+
+	properties := map[string]PropertyValue{}
+	for _, f := range fields {
+		if f.isMeta() {
+			continue
+		}
+
+		fv := rv.FieldByIndex(f.index)
+		if f.omitempty && fv.IsZero() {
+			continue
+		}
+
+		pv, err := marshalProperty(fv, f.typ)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", f.goName, err)
+		}
+		if pv != nil {
+			properties[f.name] = pv
+		}
+	}
+
+	return properties, nil
+}
+
+// marshalProperty encodes a field into a property value of the type. It
+// returns nil for the computed properties.
+func marshalProperty(fv reflect.Value, typ object.PropertyType) (PropertyValue, error) {
+	if fv.Type().Implements(marshalerType) {
+		if fv.Kind() == reflect.Ptr && fv.IsNil() {
+			return nil, nil
+		}
+		return fv.Interface().(PropertyMarshaler).MarshalNotionProperty()
+	}
+	if reflect.PtrTo(fv.Type()).Implements(marshalerType) {
+		p := reflect.New(fv.Type())
+		p.Elem().Set(fv)
+		return p.Interface().(PropertyMarshaler).MarshalNotionProperty()
+	}
+
+	switch typ {
+	case "":
+		return nil, errors.New("property type is missing in the notion tag")
+	case object.FormulaPropertyType, object.RollupPropertyType,
+		object.CreatedTimePropertyType, object.CreatedByPropertyType,
+		object.LastEditedTimePropertyType, object.LastEditedByPropertyType:
+		return nil, nil
+	}
+
+	if fv.Kind() == reflect.Ptr && fv.Type().Elem() != reflect.TypeOf(SelectOption{}) && fv.Type().Elem() != reflect.TypeOf(Date{}) {
+		if fv.IsNil() {
+			return emptyProperty(typ)
+		}
+		fv = fv.Elem()
+	}
+
+	x := fv.Interface()
+	switch typ {
+	case object.TitlePropertyType, object.TextPropertyType:
+		var texts []TextObject
+		switch x := x.(type) {
+		case []TextObject:
+			texts = x
+		default:
+			if fv.Kind() != reflect.String {
+				return nil, unsupported(fv, typ)
+			}
+			texts = []TextObject{}
+			if s := fv.String(); s != "" {
+				texts = append(texts, TextObject{Type: TextRichTextType, Text: &Text{Content: s}})
+			}
+		}
+		if typ == object.TitlePropertyType {
+			return &TitlePropertyValue{Title: texts}, nil
+		}
+		return &TextPropertyValue{Text: texts}, nil
+	case object.NumberPropertyType:
+		var n float64
+		switch fv.Kind() {
+		case reflect.Float32, reflect.Float64:
+			n = fv.Float()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			n = float64(fv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			n = float64(fv.Uint())
+		default:
+			return nil, unsupported(fv, typ)
+		}
+		return &NumberPropertyValue{Number: &n}, nil
+	case object.CheckboxPropertyType:
+		if fv.Kind() != reflect.Bool {
+			return nil, unsupported(fv, typ)
+		}
+		return &CheckboxPropertyValue{Checkbox: fv.Bool()}, nil
+	case object.SelectPropertyType:
+		switch x := x.(type) {
+		case *SelectOption:
+			return &SelectPropertyValue{Select: x}, nil
+		case SelectOption:
+			return &SelectPropertyValue{Select: &x}, nil
+		}
+		if fv.Kind() != reflect.String {
+			return nil, unsupported(fv, typ)
+		}
+		if fv.String() == "" {
+			return &SelectPropertyValue{}, nil
+		}
+		return &SelectPropertyValue{Select: &SelectOption{Name: fv.String()}}, nil
+	case object.MultiSelectPropertyType:
+		if options, ok := x.([]MultiSelectOption); ok {
+			if options == nil {
+				options = []MultiSelectOption{}
+			}
+			return &MultiSelectPropertyValue{MultiSelect: options}, nil
+		}
+		names, err := stringSlice(fv, typ)
+		if err != nil {
+			return nil, err
+		}
+		options := []MultiSelectOption{}
+		for _, name := range names {
+			options = append(options, MultiSelectOption{Name: name})
+		}
+		return &MultiSelectPropertyValue{MultiSelect: options}, nil
+	case object.DatePropertyType:
+		switch x := x.(type) {
+		case *Date:
+			return &DatePropertyValue{Date: x}, nil
+		case Date:
+			return &DatePropertyValue{Date: &x}, nil
+		case time.Time:
+			if x.IsZero() {
+				return &DatePropertyValue{}, nil
+			}
+			return &DatePropertyValue{Date: &Date{Start: formatDate(x)}}, nil
+		}
+		if fv.Kind() != reflect.String {
+			return nil, unsupported(fv, typ)
+		}
+		if fv.String() == "" {
+			return &DatePropertyValue{}, nil
+		}
+		return &DatePropertyValue{Date: &Date{Start: fv.String()}}, nil
+	case object.PeoplePropertyType:
+		switch x := x.(type) {
+		case []*User:
+			if x == nil {
+				x = []*User{}
+			}
+			return &PeoplePropertyValue{People: x}, nil
+		case []User:
+			people := []*User{}
+			for i := range x {
+				people = append(people, &x[i])
+			}
+			return &PeoplePropertyValue{People: people}, nil
+		}
+		ids, err := stringSlice(fv, typ)
+		if err != nil {
+			return nil, err
+		}
+		people := []*User{}
+		for _, id := range ids {
+			people = append(people, &User{ID: id})
+		}
+		return &PeoplePropertyValue{People: people}, nil
+	case object.RelationPropertyType:
+		if refs, ok := x.([]PageReference); ok {
+			if refs == nil {
+				refs = []PageReference{}
+			}
+			return &RelationPropertyValue{Relation: refs}, nil
+		}
+		ids, err := stringSlice(fv, typ)
+		if err != nil {
+			return nil, err
+		}
+		refs := []PageReference{}
+		for _, id := range ids {
+			refs = append(refs, PageReference{ID: id})
+		}
+		return &RelationPropertyValue{Relation: refs}, nil
+	case object.FilesPropertyType:
+		if files, ok := x.([]File); ok {
+			if files == nil {
+				files = []File{}
+			}
+			return &FilesPropertyValue{Files: files}, nil
+		}
+		urls, err := stringSlice(fv, typ)
+		if err != nil {
+			return nil, err
+		}
+		files := []File{}
+		for _, url := range urls {
+			files = append(files, File{Name: url, Type: "external", External: &FileLink{URL: url}})
+		}
+		return &FilesPropertyValue{Files: files}, nil
+	case object.URLPropertyType, object.EmailPropertyType, object.PhoneNumberPropertyType:
+		if fv.Kind() != reflect.String {
+			return nil, unsupported(fv, typ)
+		}
+		if fv.String() == "" {
+			return emptyProperty(typ)
+		}
+		s := fv.String()
+		switch typ {
+		case object.URLPropertyType:
+			return &URLPropertyValue{URL: &s}, nil
+		case object.EmailPropertyType:
+			return &EmailPropertyValue{Email: &s}, nil
+		default:
+			return &PhoneNumberPropertyValue{PhoneNumber: &s}, nil
+		}
+	default:
+		return nil, fmt.Errorf("unsupported property type %q", typ)
+	}
+}
+
+// emptyProperty returns the value clearing a property of the type.
+func emptyProperty(typ object.PropertyType) (PropertyValue, error) {
+	switch typ {
+	case object.TitlePropertyType:
+		return &TitlePropertyValue{Title: []TextObject{}}, nil
+	case object.TextPropertyType:
+		return &TextPropertyValue{Text: []TextObject{}}, nil
+	case object.MultiSelectPropertyType:
+		return &MultiSelectPropertyValue{MultiSelect: []MultiSelectOption{}}, nil
+	case object.PeoplePropertyType:
+		return &PeoplePropertyValue{People: []*User{}}, nil
+	case object.RelationPropertyType:
+		return &RelationPropertyValue{Relation: []PageReference{}}, nil
+	case object.FilesPropertyType:
+		return &FilesPropertyValue{Files: []File{}}, nil
+	case object.CheckboxPropertyType:
+		return &CheckboxPropertyValue{}, nil
+	default:
+		return newPropertyValue(typ)
+	}
+}
+
+func stringSlice(fv reflect.Value, typ object.PropertyType) ([]string, error) {
+	if fv.Kind() != reflect.Slice || fv.Type().Elem().Kind() != reflect.String {
+		return nil, unsupported(fv, typ)
+	}
+
+	s := make([]string, fv.Len())
+	for i := range s {
+		s[i] = fv.Index(i).String()
+	}
+	return s, nil
+}
+
+func unsupported(fv reflect.Value, typ object.PropertyType) error {
+	return fmt.Errorf("cannot marshal %s into %s property", fv.Type(), typ)
+}
@@ -0,0 +1,295 @@
+package notion
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+type priority int
+
+func (p *priority) UnmarshalNotionProperty(v PropertyValue) error {
+	s, ok := v.(*SelectPropertyValue)
+	if !ok || s.Select == nil {
+		return errors.New("priority must be a select")
+	}
+	*p = priority(strings.Count(s.Select.Name, "!"))
+	return nil
+}
+
+func (p priority) MarshalNotionProperty() (PropertyValue, error) {
+	return &SelectPropertyValue{Select: &SelectOption{Name: strings.Repeat("!", int(p))}}, nil
+}
+
+type grocery struct {
+	ID       string    `notion:",id"`
+	Edited   time.Time `notion:",last_edited_time"`
+	Name     string    `notion:"Name,title"`
+	Price    *float64  `notion:"Price,number"`
+	Quantity int       `notion:"Quantity,number"`
+	InStock  bool      `notion:"In stock,checkbox"`
+	Group    string    `notion:"Food group,select"`
+	Tags     []string  `notion:"Tags,multi_select"`
+	Ordered  time.Time `notion:"Last ordered,date"`
+	Expires  *string   `notion:"Expires,date"`
+	Meals    []string  `notion:"Meals,relation"`
+	Buyers   []string  `notion:"+1,people"`
+	Website  string    `notion:"Website,url,omitempty"`
+	Priority priority  `notion:"Priority"`
+	Cost     float64   `notion:"Cost,formula"`
+	Ignored  string
+}
+
+func groceryPage() *Page {
+	price, cost, url := 2.5, 7.5, "https://example.com"
+	return &Page{
+		ID:             "b55c9c91",
+		LastEditedTime: "2021-05-12T10:00:00.000Z",
+		Properties: map[string]PropertyValue{
+			"Name":         &TitlePropertyValue{Type: object.TitlePropertyType, Title: []TextObject{{PlainText: "Avocado"}}},
+			"Price":        &NumberPropertyValue{Type: object.NumberPropertyType, Number: &price},
+			"Quantity":     &NumberPropertyValue{Type: object.NumberPropertyType},
+			"In stock":     &CheckboxPropertyValue{Type: object.CheckboxPropertyType, Checkbox: true},
+			"Food group":   &SelectPropertyValue{Type: object.SelectPropertyType, Select: &SelectOption{Name: "Fruit"}},
+			"Tags":         &MultiSelectPropertyValue{Type: object.MultiSelectPropertyType, MultiSelect: []MultiSelectOption{{Name: "green"}, {Name: "ripe"}}},
+			"Last ordered": &DatePropertyValue{Type: object.DatePropertyType, Date: &Date{Start: "2021-05-10"}},
+			"Expires":      &DatePropertyValue{Type: object.DatePropertyType},
+			"Meals":        &RelationPropertyValue{Type: object.RelationPropertyType, Relation: []PageReference{{ID: "p1"}}},
+			"+1":           &PeoplePropertyValue{Type: object.PeoplePropertyType, People: []*User{{ID: "u1"}}},
+			"Website":      &URLPropertyValue{Type: object.URLPropertyType, URL: &url},
+			"Priority":     &SelectPropertyValue{Type: object.SelectPropertyType, Select: &SelectOption{Name: "!!"}},
+			"Cost":         &FormulaPropertyValue{Type: object.FormulaPropertyType, Formula: &FormulaValue{Type: "number", Number: &cost}},
+			"Photos":       &FilesPropertyValue{Type: object.FilesPropertyType, Files: []File{{Name: "avocado.png", Type: "file", File: &FileLink{URL: "https://s3.amazonaws.com/avocado.png"}}}},
+		},
+	}
+}
+
+func TestUnmarshalPage(t *testing.T) {
+	var got grocery
+	if err := UnmarshalPage(groceryPage(), &got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	price := 2.5
+	want := grocery{
+		ID:       "b55c9c91",
+		Edited:   time.Date(2021, 5, 12, 10, 0, 0, 0, time.UTC),
+		Name:     "Avocado",
+		Price:    &price,
+		InStock:  true,
+		Group:    "Fruit",
+		Tags:     []string{"green", "ripe"},
+		Ordered:  time.Date(2021, 5, 10, 0, 0, 0, 0, time.UTC),
+		Meals:    []string{"p1"},
+		Buyers:   []string{"u1"},
+		Website:  "https://example.com",
+		Priority: 2,
+		Cost:     7.5,
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestUnmarshalPage_errors(t *testing.T) {
+	tcs := map[string]struct {
+		v    interface{}
+		want error
+	}{
+		"hosted file into URLs": {
+			&struct {
+				Photos []string `notion:"Photos,files"`
+			}{},
+			nil,
+		},
+		"type mismatch": {
+			&struct {
+				Name string `notion:"Name,text"`
+			}{},
+			&PropertyTypeError{},
+		},
+		"not a pointer": {
+			grocery{},
+			nil,
+		},
+		"fractional number into int": {
+			&struct {
+				Price int `notion:"Price,number"`
+			}{},
+			nil,
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			err := UnmarshalPage(groceryPage(), tc.v)
+			if err == nil {
+				t.Fatalf("expected error")
+			}
+
+			var typeErr *PropertyTypeError
+			switch tc.want.(type) {
+			case *PropertyTypeError:
+				if !errors.As(err, &typeErr) {
+					t.Fatalf("expected type error got:%v", err)
+				}
+			case nil:
+			default:
+				if !errors.Is(err, tc.want) {
+					t.Fatalf("expected %v got:%v", tc.want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestMarshalProperties(t *testing.T) {
+	row := grocery{
+		ID:       "ignored",
+		Name:     "Avocado",
+		Quantity: 0,
+		InStock:  false,
+		Group:    "Fruit",
+		Tags:     []string{"green"},
+		Ordered:  time.Date(2021, 5, 10, 0, 0, 0, 0, time.UTC),
+		Meals:    []string{"p1"},
+		Priority: 3,
+		Cost:     7.5,
+	}
+
+	got, err := MarshalProperties(&row)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var gotJSON, wantJSON interface{}
+	if err := json.Unmarshal(b, &gotJSON); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{
+		"Name": {"title": [{"type": "text", "text": {"content": "Avocado"}}]},
+		"Price": {"number": null},
+		"Quantity": {"number": 0},
+		"In stock": {"checkbox": false},
+		"Food group": {"select": {"name": "Fruit"}},
+		"Tags": {"multi_select": [{"name": "green"}]},
+		"Last ordered": {"date": {"start": "2021-05-10"}},
+		"Expires": {"date": null},
+		"Meals": {"relation": [{"id": "p1"}]},
+		"+1": {"people": []},
+		"Priority": {"select": {"name": "!!!"}}
+	}`), &wantJSON); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(gotJSON, wantJSON); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestMarshalProperties_roundTrip(t *testing.T) {
+	var row grocery
+	if err := UnmarshalPage(groceryPage(), &row); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	properties, err := MarshalProperties(row)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var got grocery
+	if err := UnmarshalPage(&Page{ID: row.ID, Properties: properties}, &got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if got.Cost != 0 {
+		t.Fatalf("formula which is not marshaled got:%v want:0", got.Cost)
+	}
+
+	properties["Cost"] = groceryPage().Properties["Cost"]
+	if err := UnmarshalPage(&Page{ID: row.ID, LastEditedTime: "2021-05-12T10:00:00Z", Properties: properties}, &got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, row); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestUnmarshalPage_nilPage(t *testing.T) {
+	var row grocery
+	if err := UnmarshalPage(nil, &row); err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestUnmarshalPage_missingProperty(t *testing.T) {
+	got := struct {
+		Name    string   `notion:"Name,title"`
+		Missing *float64 `notion:"Missing,number"`
+		Tags    []string `notion:"Missing tags,multi_select"`
+	}{Tags: []string{"stale"}}
+	price := 1.0
+	got.Missing = &price
+
+	if err := UnmarshalPage(groceryPage(), &got); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if got.Name != "Avocado" || got.Missing != nil || got.Tags != nil {
+		t.Fatalf("missing properties not decoded as empty got:%+v", got)
+	}
+}
+
+func TestFiles_roundTrip(t *testing.T) {
+	hosted := File{Name: "photo.png", Type: "file", File: &FileLink{URL: "https://s3.amazonaws.com/photo.png?X-Amz-Expires=3600", ExpiryTime: "2021-05-12T11:00:00.000Z"}}
+	external := File{Name: "logo", Type: "external", External: &FileLink{URL: "https://example.com/logo.png"}}
+	page := &Page{Properties: map[string]PropertyValue{
+		"Photos": &FilesPropertyValue{Type: object.FilesPropertyType, Files: []File{hosted, external}},
+		"Logos":  &FilesPropertyValue{Type: object.FilesPropertyType, Files: []File{external}},
+	}}
+
+	var row struct {
+		Photos []File   `notion:"Photos,files"`
+		Logos  []string `notion:"Logos,files"`
+	}
+	if err := UnmarshalPage(page, &row); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	properties, err := MarshalProperties(&row)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := map[string]PropertyValue{
+		"Photos": &FilesPropertyValue{Files: []File{hosted, external}},
+		"Logos":  &FilesPropertyValue{Files: []File{{Name: "https://example.com/logo.png", Type: "external", External: &FileLink{URL: "https://example.com/logo.png"}}}},
+	}
+	if diff := cmp.Diff(properties, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestMarshalProperties_missingType(t *testing.T) {
+	_, err := MarshalProperties(struct {
+		Name string `notion:"Name"`
+	}{})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
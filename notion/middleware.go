@@ -0,0 +1,51 @@
+package notion
+
+import (
+	"context"
+	"net/http"
+)
+
+// Call represents a single attempt of an API request, after its body has been
+// encoded and its headers set.
+type Call struct {
+	Method string
+	// Path is the API path relative to the version prefix, e.g. "pages/<page id>".
+	Path   string
+	Header http.Header
+	Body   []byte
+}
+
+// Doer sends a Call to the Notion API.
+//
+// On API errors the returned error is an *Error, and the response, whose body
+// has already been consumed, is returned alongside it.
+type Doer interface {
+	Do(ctx context.Context, call *Call) (*http.Response, error)
+}
+
+// DoerFunc is an adapter to use ordinary functions as Doer.
+type DoerFunc func(ctx context.Context, call *Call) (*http.Response, error)
+
+// Do implements the Doer interface.
+func (f DoerFunc) Do(ctx context.Context, call *Call) (*http.Response, error) {
+	return f(ctx, call)
+}
+
+// Middleware wraps a Doer to add behaviour around every API call.
+type Middleware func(next Doer) Doer
+
+// WithMiddleware appends middlewares to the request pipeline.
+// The first middleware given is the outermost one. Middlewares run once per
+// attempt when retries are enabled.
+func WithMiddleware(middlewares ...Middleware) ClientOption {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, middlewares...)
+	}
+}
+
+func chainMiddlewares(middlewares []Middleware, doer Doer) Doer {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		doer = middlewares[i](doer)
+	}
+	return doer
+}
@@ -0,0 +1,106 @@
+package notion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestWithMiddleware(t *testing.T) {
+	var order []string
+	record := func(name string) Middleware {
+		return func(next Doer) Doer {
+			return DoerFunc(func(ctx context.Context, call *Call) (*http.Response, error) {
+				order = append(order, fmt.Sprintf("%s %s %s %s", name, call.Method, call.Path, call.Body))
+				return next.Do(ctx, call)
+			})
+		}
+	}
+
+	requestID := func(next Doer) Doer {
+		return DoerFunc(func(ctx context.Context, call *Call) (*http.Response, error) {
+			call.Header.Set("X-Request-Id", "req-1")
+			return next.Do(ctx, call)
+		})
+	}
+
+	client, mux, _, teardown := setup(WithMiddleware(record("outer"), record("inner"), requestID))
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/%s/query", databasesPath, "db"), func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("X-Request-Id"); got != "req-1" {
+			t.Fatalf("request id header not set got:%s", got)
+		}
+
+		fmt.Fprint(w, `{"object": "list", "results": []}`)
+	})
+
+	if _, err := client.Databases.Query(context.Background(), "db", &DatabaseQuery{PageSize: 1}); err != nil {
+		t.Fatalf("failed: %v", err)
+	}
+
+	want := []string{
+		"outer POST databases/db/query {\"page_size\":1}\n",
+		"inner POST databases/db/query {\"page_size\":1}\n",
+	}
+	if diff := cmp.Diff(order, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestWithMiddleware_Error(t *testing.T) {
+	tcs := map[string]struct {
+		middleware Middleware
+		wantErr    func(err error) bool
+	}{
+		"api error": {
+			func(next Doer) Doer {
+				return DoerFunc(func(ctx context.Context, call *Call) (*http.Response, error) {
+					resp, err := next.Do(ctx, call)
+					var apiErr *Error
+					if !errors.As(err, &apiErr) || apiErr.Status != http.StatusNotFound {
+						t.Fatalf("middleware did not get the API error got:%v", err)
+					}
+					return resp, err
+				})
+			},
+			func(err error) bool {
+				var apiErr *Error
+				return errors.As(err, &apiErr)
+			},
+		},
+		"fault injection": {
+			func(next Doer) Doer {
+				return DoerFunc(func(ctx context.Context, call *Call) (*http.Response, error) {
+					return nil, errInjected
+				})
+			},
+			func(err error) bool {
+				return errors.Is(err, errInjected)
+			},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client, mux, _, teardown := setup(WithMiddleware(tc.middleware))
+			defer teardown()
+
+			mux.HandleFunc(fmt.Sprintf("/%s/%s", usersPath, "missing"), func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusNotFound)
+				fmt.Fprint(w, getErrorJSON(http.StatusNotFound))
+			})
+
+			_, err := client.Users.Get(context.Background(), "missing")
+			if !tc.wantErr(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+var errInjected = errors.New("injected")
@@ -0,0 +1,404 @@
+// Package migrate keeps the property schema of Notion databases in sync with a
+// schema declared in Go.
+//
+// A Plan is computed by diffing the declared Schema against the database
+// returned by the API, can be printed as a dry run, and is then applied with
+// a single database update:
+//
+//	plan, err := migrate.NewPlan(ctx, client, databaseID, &migrate.Schema{
+//		Properties: map[string]notion.PropertyConfig{
+//			"Name":   &notion.TitlePropertyConfig{},
+//			"Status": &notion.SelectPropertyConfig{Options: []notion.SelectOption{{Name: "Done", Color: notion.GreenColor}}},
+//		},
+//	})
+//	fmt.Print(plan)
+//	_, err = plan.Apply(ctx, client)
+package migrate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ketion-so/go-notion/notion"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// Schema is the desired property schema of a database.
+type Schema struct {
+	// Properties maps the name of each property to its desired schema, e.g.
+	// a *notion.SelectPropertyConfig with its options.
+	Properties map[string]notion.PropertyConfig
+
+	// Renames maps the previous name of a property to its name in
+	// Properties, so that the property is renamed instead of being added.
+	Renames map[string]string
+
+	// Prune removes the properties of the database which are not declared.
+	// The title property is never removed.
+	Prune bool
+}
+
+// Action is the kind of a Change.
+type Action string
+
+const (
+	Add    Action = "add"
+	Rename Action = "rename"
+	Retype Action = "retype"
+	Update Action = "update"
+	Remove Action = "remove"
+)
+
+// Change is a single change of a Plan.
+type Change struct {
+	Action Action
+
+	// Name is the name of the property once migrated, or the name of the
+	// removed property.
+	Name string
+
+	// OldName is the previous name of a renamed property.
+	OldName string
+
+	// OldType and NewType are the types of the property before and after
+	// the change, when relevant.
+	OldType object.PropertyType
+	NewType object.PropertyType
+
+	// Detail describes an update, e.g. the select options added.
+	Detail string
+
+	key      string
+	property notion.PropertyConfig
+}
+
+// String describes the change on a single line.
+func (c *Change) String() string {
+	switch c.Action {
+	case Add:
+		return fmt.Sprintf("+ add %q (%s)", c.Name, c.NewType)
+	case Rename:
+		return fmt.Sprintf("~ rename %q to %q", c.OldName, c.Name)
+	case Retype:
+		return fmt.Sprintf("~ retype %q (%s -> %s)", c.Name, c.OldType, c.NewType)
+	case Update:
+		return fmt.Sprintf("~ update %q: %s", c.Name, c.Detail)
+	case Remove:
+		return fmt.Sprintf("- remove %q (%s)", c.Name, c.OldType)
+	default:
+		return fmt.Sprintf("? %s %q", c.Action, c.Name)
+	}
+}
+
+// Plan is the list of changes migrating a database to a Schema.
+type Plan struct {
+	DatabaseID string
+	Changes    []*Change
+}
+
+// NewPlan retrieves the database and computes the plan migrating it to the
+// schema.
+func NewPlan(ctx context.Context, client *notion.Client, databaseID string, schema *Schema, opts ...notion.RequestOption) (*Plan, error) {
+	db, err := client.Databases.Get(ctx, databaseID, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := Diff(db, schema)
+	if err != nil {
+		return nil, err
+	}
+	plan.DatabaseID = databaseID
+
+	return plan, nil
+}
+
+// Diff computes the plan migrating the database to the schema.
+//
+// A database has exactly one title property, so the title property of the
+// schema is matched with the title property of the database: it is renamed
+// when the names differ.
+func Diff(db *notion.Database, schema *Schema) (*Plan, error) {
+	if db == nil {
+		return nil, errors.New("current database is nil")
+	}
+	if schema == nil {
+		return nil, errors.New("schema is nil")
+	}
+
+	renamedFrom := map[string]string{}
+	for old, name := range schema.Renames {
+		if _, ok := schema.Properties[name]; !ok {
+			return nil, fmt.Errorf("%s is renamed to %s which is not declared", old, name)
+		}
+		renamedFrom[name] = old
+	}
+
+	if err := matchTitle(db, schema, renamedFrom); err != nil {
+		return nil, err
+	}
+
+	plan := &Plan{DatabaseID: db.ID}
+	kept := map[string]bool{}
+
+	for _, name := range sortedNames(schema.Properties) {
+		want := schema.Properties[name]
+		wantType, err := typeOf(want)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		key := name
+		cur, ok := db.Properties[name]
+		if !ok {
+			if old, renamed := renamedFrom[name]; renamed {
+				if cur, ok = db.Properties[old]; ok {
+					key = old
+					plan.Changes = append(plan.Changes, &Change{Action: Rename, Name: name, OldName: old, key: key})
+				}
+			}
+		}
+
+		if !ok {
+			plan.Changes = append(plan.Changes, &Change{Action: Add, Name: name, NewType: wantType, key: key, property: want})
+			continue
+		}
+		kept[key] = true
+
+		if cur.GetType() != wantType {
+			plan.Changes = append(plan.Changes, &Change{Action: Retype, Name: name, OldType: cur.GetType(), NewType: wantType, key: key, property: want})
+			continue
+		}
+
+		if detail, merged := diffConfig(cur, want); detail != "" {
+			plan.Changes = append(plan.Changes, &Change{Action: Update, Name: name, Detail: detail, key: key, property: merged})
+		}
+	}
+
+	if schema.Prune {
+		for _, name := range sortedNames(db.Properties) {
+			cur := db.Properties[name]
+			if kept[name] || cur.GetType() == object.TitlePropertyType {
+				continue
+			}
+			plan.Changes = append(plan.Changes, &Change{Action: Remove, Name: name, OldType: cur.GetType(), key: name})
+		}
+	}
+
+	return plan, nil
+}
+
+// matchTitle plans the rename of the title property of the database when the
+// schema names it differently.
+func matchTitle(db *notion.Database, schema *Schema, renamedFrom map[string]string) error {
+	wantTitles := titleNames(schema.Properties)
+	if len(wantTitles) > 1 {
+		return fmt.Errorf("schema declares %d title properties %q, a database has exactly one", len(wantTitles), wantTitles)
+	}
+
+	curTitles := titleNames(db.Properties)
+	if len(wantTitles) == 0 || len(curTitles) != 1 {
+		return nil
+	}
+	want, cur := wantTitles[0], curTitles[0]
+	if want == cur {
+		return nil
+	}
+
+	if p, ok := db.Properties[want]; ok {
+		return fmt.Errorf("%s: cannot retype %s to title, the title property of the database is %s", want, p.GetType(), cur)
+	}
+	if old, ok := renamedFrom[want]; ok {
+		if old != cur {
+			return fmt.Errorf("%s: cannot rename %s to the title property, the title property of the database is %s", want, old, cur)
+		}
+		return nil
+	}
+	if p, ok := schema.Properties[cur]; ok {
+		return fmt.Errorf("%s: cannot retype the title property to %s, the title property of the schema is %s", cur, p.GetType(), want)
+	}
+	if name, ok := schema.Renames[cur]; ok {
+		return fmt.Errorf("%s: cannot rename the title property to %s, the title property of the schema is %s", cur, name, want)
+	}
+
+	renamedFrom[want] = cur
+	return nil
+}
+
+// titleNames returns the sorted names of the title properties.
+func titleNames(properties map[string]notion.PropertyConfig) []string {
+	names := []string{}
+	for _, name := range sortedNames(properties) {
+		if p := properties[name]; p != nil && p.GetType() == object.TitlePropertyType {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// Empty reports whether the database already matches the schema.
+func (p *Plan) Empty() bool {
+	return len(p.Changes) == 0
+}
+
+// String prints the plan as a dry run.
+func (p *Plan) String() string {
+	if p.Empty() {
+		return fmt.Sprintf("database %s is up to date\n", p.DatabaseID)
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "database %s:\n", p.DatabaseID)
+	for _, c := range p.Changes {
+		fmt.Fprintf(&b, "  %s\n", c)
+	}
+	return b.String()
+}
+
+// Request returns the database update applying the plan.
+func (p *Plan) Request() *notion.UpdateDatabaseRequest {
+	updates := map[string]*notion.PropertyUpdate{}
+	for _, c := range p.Changes {
+		if c.Action == Remove {
+			updates[c.key] = nil
+			continue
+		}
+
+		u, ok := updates[c.key]
+		if !ok {
+			u = &notion.PropertyUpdate{}
+			updates[c.key] = u
+		}
+
+		if c.Action == Rename {
+			u.Name = c.Name
+		} else {
+			u.Property = c.property
+		}
+	}
+
+	return &notion.UpdateDatabaseRequest{Properties: updates}
+}
+
+// Apply applies the plan with a single database update. Nothing is sent when
+// the plan is empty, in which case the returned database is nil.
+func (p *Plan) Apply(ctx context.Context, client *notion.Client, opts ...notion.RequestOption) (*notion.Database, error) {
+	if p.Empty() {
+		return nil, nil
+	}
+
+	return client.Databases.Update(ctx, p.DatabaseID, p.Request(), opts...)
+}
+
+// diffConfig compares the configuration of two properties of the same type.
+// It returns a description of the differences and the property to send,
+// which keeps the existing select options.
+func diffConfig(cur, want notion.PropertyConfig) (string, notion.PropertyConfig) {
+	switch want := want.(type) {
+	case *notion.SelectPropertyConfig:
+		c, _ := cur.(*notion.SelectPropertyConfig)
+		options, detail := mergeOptions(selectOptions(c.Options), selectOptions(want.Options))
+		merged := &notion.SelectPropertyConfig{}
+		for _, o := range options {
+			merged.Options = append(merged.Options, notion.SelectOption{ID: o.id, Name: o.name, Color: o.color})
+		}
+		return detail, merged
+	case *notion.MultiSelectPropertyConfig:
+		c, _ := cur.(*notion.MultiSelectPropertyConfig)
+		options, detail := mergeOptions(multiSelectOptions(c.Options), multiSelectOptions(want.Options))
+		merged := &notion.MultiSelectPropertyConfig{}
+		for _, o := range options {
+			merged.Options = append(merged.Options, notion.MultiSelectOption{ID: o.id, Name: o.name, Color: o.color})
+		}
+		return detail, merged
+	case *notion.NumberPropertyConfig:
+		c, _ := cur.(*notion.NumberPropertyConfig)
+		if want.Format != "" && want.Format != c.Format {
+			return fmt.Sprintf("format %q -> %q", c.Format, want.Format), want
+		}
+	case *notion.FormulaPropertyConfig:
+		c, _ := cur.(*notion.FormulaPropertyConfig)
+		if want.Expression != "" && want.Expression != c.Expression {
+			return fmt.Sprintf("expression %q", want.Expression), want
+		}
+	}
+
+	return "", nil
+}
+
+type option struct {
+	id    string
+	name  string
+	color notion.Color
+}
+
+func selectOptions(opts []notion.SelectOption) []option {
+	options := []option{}
+	for _, o := range opts {
+		options = append(options, option{o.ID, o.Name, o.Color})
+	}
+	return options
+}
+
+func multiSelectOptions(opts []notion.MultiSelectOption) []option {
+	options := []option{}
+	for _, o := range opts {
+		options = append(options, option{o.ID, o.Name, o.Color})
+	}
+	return options
+}
+
+// mergeOptions adds the missing options to the existing ones and recolors
+// the existing ones, matching them by name.
+func mergeOptions(cur, want []option) ([]option, string) {
+	merged := append([]option{}, cur...)
+	index := map[string]int{}
+	for i, o := range merged {
+		index[o.name] = i
+	}
+
+	added, recolored := []string{}, []string{}
+	for _, o := range want {
+		i, ok := index[o.name]
+		if !ok {
+			merged = append(merged, option{name: o.name, color: o.color})
+			added = append(added, o.name)
+			continue
+		}
+
+		if o.color != "" && o.color != merged[i].color {
+			merged[i].color = o.color
+			recolored = append(recolored, o.name)
+		}
+	}
+
+	details := []string{}
+	if len(added) > 0 {
+		details = append(details, "add options "+strings.Join(added, ", "))
+	}
+	if len(recolored) > 0 {
+		details = append(details, "recolor options "+strings.Join(recolored, ", "))
+	}
+
+	return merged, strings.Join(details, "; ")
+}
+
+// typeOf returns the type of a declared property.
+func typeOf(p notion.PropertyConfig) (object.PropertyType, error) {
+	if p == nil {
+		return "", errors.New("property schema is nil")
+	}
+	return p.GetType(), nil
+}
+
+func sortedNames(properties map[string]notion.PropertyConfig) []string {
+	names := make([]string, 0, len(properties))
+	for name := range properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,260 @@
+package migrate
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/ketion-so/go-notion/notion"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+const testDatabaseID = "668d797c-76fa-4934-9b05-ad288df2d136"
+
+func getDatabaseJSON() string {
+	return `{
+	"object": "database",
+	"id": "668d797c-76fa-4934-9b05-ad288df2d136",
+	"title": [],
+	"properties": {
+		"Name": {"id": "title", "type": "title", "title": {}},
+		"Status": {
+			"id": "TJmr",
+			"type": "select",
+			"select": {
+				"options": [
+					{"id": "96eb622f", "name": "Todo", "color": "gray"},
+					{"id": "bb443819", "name": "Done", "color": "red"}
+				]
+			}
+		},
+		"Price": {"id": "cU^N", "type": "number", "number": {"format": "dollar"}},
+		"Notes": {"id": "p:sC", "type": "text", "text": {}},
+		"Legacy": {"id": "aGut", "type": "checkbox", "checkbox": {}}
+	}
+}`
+}
+
+func testDatabase() *notion.Database {
+	return &notion.Database{
+		ID: testDatabaseID,
+		Properties: map[string]notion.PropertyConfig{
+			"Name": &notion.TitlePropertyConfig{Type: object.TitlePropertyType, ID: "title"},
+			"Status": &notion.SelectPropertyConfig{
+				Type: object.SelectPropertyType,
+				ID:   "TJmr",
+				Options: []notion.SelectOption{
+					{ID: "96eb622f", Name: "Todo", Color: notion.GrayColor},
+					{ID: "bb443819", Name: "Done", Color: notion.RedColor},
+				},
+			},
+			"Price":  &notion.NumberPropertyConfig{Type: object.NumberPropertyType, ID: "cU^N", Format: "dollar"},
+			"Notes":  &notion.TextPropertyConfig{Type: object.TextPropertyType, ID: "p:sC"},
+			"Legacy": &notion.CheckboxPropertyConfig{Type: object.CheckboxPropertyType, ID: "aGut"},
+		},
+	}
+}
+
+func TestDiff(t *testing.T) {
+	tcs := map[string]struct {
+		schema  *Schema
+		want    []*Change
+		wantErr bool
+	}{
+		"up to date": {
+			&Schema{
+				Properties: map[string]notion.PropertyConfig{
+					"Name":   &notion.TitlePropertyConfig{},
+					"Status": &notion.SelectPropertyConfig{Options: []notion.SelectOption{{Name: "Done"}}},
+					"Price":  &notion.NumberPropertyConfig{},
+					"Notes":  &notion.TextPropertyConfig{},
+				},
+			},
+			nil,
+			false,
+		},
+		"all changes": {
+			&Schema{
+				Properties: map[string]notion.PropertyConfig{
+					"Name":    &notion.TitlePropertyConfig{},
+					"Due":     &notion.DatePropertyConfig{},
+					"State":   &notion.SelectPropertyConfig{Options: []notion.SelectOption{{Name: "Done", Color: notion.GreenColor}, {Name: "Doing"}}},
+					"Price":   &notion.TextPropertyConfig{},
+					"Remarks": &notion.TextPropertyConfig{},
+				},
+				Renames: map[string]string{"Status": "State", "Notes": "Remarks"},
+				Prune:   true,
+			},
+			[]*Change{
+				{Action: Add, Name: "Due", NewType: object.DatePropertyType},
+				{Action: Retype, Name: "Price", OldType: object.NumberPropertyType, NewType: object.TextPropertyType},
+				{Action: Rename, Name: "Remarks", OldName: "Notes"},
+				{Action: Rename, Name: "State", OldName: "Status"},
+				{Action: Update, Name: "State", Detail: "add options Doing; recolor options Done"},
+				{Action: Remove, Name: "Legacy", OldType: object.CheckboxPropertyType},
+			},
+			false,
+		},
+		"title renamed": {
+			&Schema{
+				Properties: map[string]notion.PropertyConfig{
+					"Task": &notion.TitlePropertyConfig{},
+				},
+			},
+			[]*Change{
+				{Action: Rename, Name: "Task", OldName: "Name"},
+			},
+			false,
+		},
+		"title renamed and pruned": {
+			&Schema{
+				Properties: map[string]notion.PropertyConfig{
+					"Task": &notion.TitlePropertyConfig{},
+				},
+				Prune: true,
+			},
+			[]*Change{
+				{Action: Rename, Name: "Task", OldName: "Name"},
+				{Action: Remove, Name: "Legacy", OldType: object.CheckboxPropertyType},
+				{Action: Remove, Name: "Notes", OldType: object.TextPropertyType},
+				{Action: Remove, Name: "Price", OldType: object.NumberPropertyType},
+				{Action: Remove, Name: "Status", OldType: object.SelectPropertyType},
+			},
+			false,
+		},
+		"two titles": {
+			&Schema{
+				Properties: map[string]notion.PropertyConfig{
+					"Name": &notion.TitlePropertyConfig{},
+					"Task": &notion.TitlePropertyConfig{},
+				},
+			},
+			nil,
+			true,
+		},
+		"title retyped": {
+			&Schema{
+				Properties: map[string]notion.PropertyConfig{
+					"Name":  &notion.TextPropertyConfig{},
+					"Notes": &notion.TitlePropertyConfig{},
+				},
+			},
+			nil,
+			true,
+		},
+		"rename to undeclared property": {
+			&Schema{
+				Properties: map[string]notion.PropertyConfig{},
+				Renames:    map[string]string{"Status": "State"},
+			},
+			nil,
+			true,
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := Diff(testDatabase(), tc.schema)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("expected error")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got.Changes, tc.want, cmpopts.IgnoreUnexported(Change{})); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestDiff_nil(t *testing.T) {
+	if _, err := Diff(nil, &Schema{}); err == nil {
+		t.Fatalf("expected error for nil database")
+	}
+
+	if _, err := Diff(testDatabase(), nil); err == nil {
+		t.Fatalf("expected error for nil schema")
+	}
+}
+
+func TestPlan_Apply(t *testing.T) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	var got interface{}
+	mux.HandleFunc("/v1/databases/"+testDatabaseID, func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodPatch {
+			if err := json.NewDecoder(r.Body).Decode(&got); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+		}
+		fmt.Fprint(w, getDatabaseJSON())
+	})
+
+	client := notion.NewClient("secret", notion.WithLimiter(nil))
+	client.BaseURL, _ = url.Parse(server.URL + "/")
+
+	plan, err := NewPlan(context.Background(), client, testDatabaseID, &Schema{
+		Properties: map[string]notion.PropertyConfig{
+			"Name":   &notion.TitlePropertyConfig{},
+			"Status": &notion.SelectPropertyConfig{Options: []notion.SelectOption{{Name: "Blocked", Color: notion.RedColor}}},
+			"Price":  &notion.NumberPropertyConfig{Format: "euro"},
+			"Notes":  &notion.TextPropertyConfig{},
+			"Owner":  &notion.PeoplePropertyConfig{},
+		},
+		Prune: true,
+	})
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	wantPlan := `database 668d797c-76fa-4934-9b05-ad288df2d136:
+  + add "Owner" (people)
+  ~ update "Price": format "dollar" -> "euro"
+  ~ update "Status": add options Blocked
+  - remove "Legacy" (checkbox)
+`
+	if diff := cmp.Diff(plan.String(), wantPlan); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if _, err := plan.Apply(context.Background(), client); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	var want interface{}
+	if err := json.Unmarshal([]byte(`{
+		"properties": {
+			"Owner": {"people": {}},
+			"Price": {"number": {"format": "euro"}},
+			"Status": {"select": {"options": [
+				{"id": "96eb622f", "name": "Todo", "color": "gray"},
+				{"id": "bb443819", "name": "Done", "color": "red"},
+				{"name": "Blocked", "color": "red"}
+			]}},
+			"Legacy": null
+		}
+	}`), &want); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
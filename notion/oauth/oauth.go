@@ -0,0 +1,174 @@
+// Package oauth implements the OAuth 2.0 flow of Notion public integrations.
+//
+// API doc: https://developers.notion.com/docs/authorization
+package oauth
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+
+	"github.com/ketion-so/go-notion/notion"
+)
+
+const (
+	defaultBaseURL = "https://api.notion.com"
+	authorizePath  = "v1/oauth/authorize"
+	tokenPath      = "v1/oauth/token"
+
+	authorizationCodeGrantType = "authorization_code"
+)
+
+// Config describes a Notion public integration.
+type Config struct {
+	ClientID     string
+	ClientSecret string
+	// RedirectURL is the redirect URI registered for the integration.
+	RedirectURL string
+
+	// BaseURL overrides the Notion API base URL.
+	BaseURL *url.URL
+	// HTTPClient overrides the default http.Client used to exchange codes.
+	HTTPClient *http.Client
+}
+
+// Token represents the access token granted to a public integration, with the
+// metadata of the workspace it has been granted for.
+type Token struct {
+	AccessToken   string `json:"access_token"`
+	TokenType     string `json:"token_type"`
+	BotID         string `json:"bot_id"`
+	WorkspaceID   string `json:"workspace_id"`
+	WorkspaceName string `json:"workspace_name"`
+	WorkspaceIcon string `json:"workspace_icon"`
+	Owner         *Owner `json:"owner"`
+}
+
+// TokenSource returns a notion.TokenSource authenticating with the token.
+func (t *Token) TokenSource() notion.TokenSource {
+	return notion.StaticTokenSource(t.AccessToken)
+}
+
+// OwnerType is the type of the owner of a token.
+type OwnerType string
+
+const (
+	UserOwner      OwnerType = "user"
+	WorkspaceOwner OwnerType = "workspace"
+)
+
+// Owner represents who the token has been granted by.
+type Owner struct {
+	Type      OwnerType    `json:"type"`
+	User      *notion.User `json:"user,omitempty"`
+	Workspace bool         `json:"workspace,omitempty"`
+}
+
+// Error represents an error returned by the token endpoint.
+type Error struct {
+	StatusCode  int    `json:"-"`
+	Code        string `json:"error"`
+	Description string `json:"error_description"`
+	// Body is the raw body of the HTTP response.
+	Body []byte `json:"-"`
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	if e.Description != "" {
+		return fmt.Sprintf("oauth: %s: %s", e.Code, e.Description)
+	}
+
+	if e.Code != "" {
+		return fmt.Sprintf("oauth: %s", e.Code)
+	}
+
+	return fmt.Sprintf("oauth: unexpected status %d", e.StatusCode)
+}
+
+// AuthCodeURL returns the URL of the consent page users are sent to.
+// The state is passed back to the redirect URL to protect against CSRF.
+func (c *Config) AuthCodeURL(state string) string {
+	v := url.Values{}
+	v.Set("client_id", c.ClientID)
+	v.Set("response_type", "code")
+	v.Set("owner", string(UserOwner))
+	if c.RedirectURL != "" {
+		v.Set("redirect_uri", c.RedirectURL)
+	}
+	if state != "" {
+		v.Set("state", state)
+	}
+
+	u := c.endpoint(authorizePath)
+	u.RawQuery = v.Encode()
+	return u.String()
+}
+
+type tokenRequest struct {
+	GrantType   string `json:"grant_type"`
+	Code        string `json:"code"`
+	RedirectURI string `json:"redirect_uri,omitempty"`
+}
+
+// Exchange exchanges the code received on the redirect URL for an access token.
+//
+// API doc: https://developers.notion.com/docs/authorization#exchanging-the-grant-for-an-access-token
+func (c *Config) Exchange(ctx context.Context, code string) (*Token, error) {
+	body, err := json.Marshal(&tokenRequest{
+		GrantType:   authorizationCodeGrantType,
+		Code:        code,
+		RedirectURI: c.RedirectURL,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint(tokenPath).String(), bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.SetBasicAuth(c.ClientID, c.ClientSecret)
+	req.Header.Set("Content-Type", "application/json")
+
+	client := c.HTTPClient
+	if client == nil {
+		client = http.DefaultClient
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		b, _ := io.ReadAll(resp.Body)
+		oauthErr := &Error{}
+		_ = json.Unmarshal(b, oauthErr)
+		oauthErr.StatusCode = resp.StatusCode
+		oauthErr.Body = b
+		return nil, oauthErr
+	}
+
+	token := &Token{}
+	if err := json.NewDecoder(resp.Body).Decode(token); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func (c *Config) endpoint(path string) *url.URL {
+	base := c.BaseURL
+	if base == nil {
+		base, _ = url.Parse(defaultBaseURL)
+	}
+
+	u, _ := base.Parse(path)
+	return u
+}
@@ -0,0 +1,174 @@
+package oauth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+const (
+	testClientID     = "463558a3-725e-4f37-b6d3-0889894f68de"
+	testClientSecret = "secret"
+	testRedirectURL  = "https://example.com/auth/notion/callback"
+)
+
+func getTokenJSON() string {
+	return `{
+	"access_token": "secret_e7d7c8b9",
+	"token_type": "bearer",
+	"bot_id": "b3414d65-9cd4-4e2a-8e3b-fd4d39a8e7b6",
+	"workspace_id": "c5b21c40-1cd4-4a8f-8c8c-8c2b4e8c8f4a",
+	"workspace_name": "Acme",
+	"workspace_icon": "https://example.com/icon.png",
+	"owner": {
+		"type": "user",
+		"user": {
+			"object": "user",
+			"id": "d40e767c-d7af-4b18-a86d-55c61f1e39a4",
+			"type": "person",
+			"name": "Avocado Lovelace"
+		}
+	}
+}`
+}
+
+func setup() (*Config, *http.ServeMux, func()) {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	u, _ := url.Parse(server.URL)
+
+	return &Config{
+		ClientID:     testClientID,
+		ClientSecret: testClientSecret,
+		RedirectURL:  testRedirectURL,
+		BaseURL:      u,
+	}, mux, server.Close
+}
+
+func TestConfig_AuthCodeURL(t *testing.T) {
+	tcs := map[string]struct {
+		config *Config
+		state  string
+		want   string
+	}{
+		"ok": {
+			&Config{ClientID: testClientID, RedirectURL: testRedirectURL},
+			"xyz",
+			"https://api.notion.com/v1/oauth/authorize?client_id=" + testClientID + "&owner=user&redirect_uri=" + url.QueryEscape(testRedirectURL) + "&response_type=code&state=xyz",
+		},
+		"no state": {
+			&Config{ClientID: testClientID},
+			"",
+			"https://api.notion.com/v1/oauth/authorize?client_id=" + testClientID + "&owner=user&response_type=code",
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			if got := tc.config.AuthCodeURL(tc.state); got != tc.want {
+				t.Fatalf("url got:%s want:%s", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestConfig_Exchange(t *testing.T) {
+	config, mux, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+tokenPath, func(w http.ResponseWriter, r *http.Request) {
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != testClientID || pass != testClientSecret {
+			t.Fatalf("no basic auth got:%s:%s", user, pass)
+		}
+
+		req := &tokenRequest{}
+		if err := json.NewDecoder(r.Body).Decode(req); err != nil {
+			t.Fatalf("failed to decode request: %v", err)
+		}
+
+		if req.Code != "valid" {
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error": "invalid_grant", "error_description": "Invalid code."}`)
+			return
+		}
+
+		if req.GrantType != authorizationCodeGrantType || req.RedirectURI != testRedirectURL {
+			t.Fatalf("unexpected request: %+v", req)
+		}
+
+		fmt.Fprint(w, getTokenJSON())
+	})
+
+	tcs := map[string]struct {
+		code    string
+		want    *Token
+		wantErr *Error
+	}{
+		"ok": {
+			code: "valid",
+			want: &Token{
+				AccessToken:   "secret_e7d7c8b9",
+				TokenType:     "bearer",
+				BotID:         "b3414d65-9cd4-4e2a-8e3b-fd4d39a8e7b6",
+				WorkspaceID:   "c5b21c40-1cd4-4a8f-8c8c-8c2b4e8c8f4a",
+				WorkspaceName: "Acme",
+				WorkspaceIcon: "https://example.com/icon.png",
+				Owner: &Owner{
+					Type: UserOwner,
+					User: &notion.User{
+						ID:   "d40e767c-d7af-4b18-a86d-55c61f1e39a4",
+						Type: object.Person,
+						Name: "Avocado Lovelace",
+					},
+				},
+			},
+		},
+		"invalid grant": {
+			code: "invalid",
+			wantErr: &Error{
+				StatusCode:  http.StatusBadRequest,
+				Code:        "invalid_grant",
+				Description: "Invalid code.",
+			},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			got, err := config.Exchange(context.Background(), tc.code)
+			if tc.wantErr != nil {
+				var oauthErr *Error
+				if !errors.As(err, &oauthErr) {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				oauthErr.Body = nil
+
+				if diff := cmp.Diff(oauthErr, tc.wantErr); diff != "" {
+					t.Fatalf("Diff: %s(-got +want)", diff)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+package oauth
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/ketion-so/go-notion/notion"
+)
+
+// TokenStore looks up the token granted for a workspace.
+type TokenStore interface {
+	Token(ctx context.Context, workspaceID string) (*Token, error)
+}
+
+// WorkspaceTokenSource returns a notion.TokenSource looking up the token of
+// the workspace in the store on every request, so a token replaced in the
+// store is used right away.
+func WorkspaceTokenSource(store TokenStore, workspaceID string) notion.TokenSource {
+	return notion.TokenSourceFunc(func(ctx context.Context) (string, error) {
+		token, err := store.Token(ctx, workspaceID)
+		if err != nil {
+			return "", err
+		}
+		return token.AccessToken, nil
+	})
+}
+
+// MemoryTokenStore is a TokenStore keeping the tokens in memory.
+type MemoryTokenStore struct {
+	mu     sync.RWMutex
+	tokens map[string]*Token
+}
+
+// NewMemoryTokenStore returns an empty MemoryTokenStore.
+func NewMemoryTokenStore() *MemoryTokenStore {
+	return &MemoryTokenStore{
+		tokens: map[string]*Token{},
+	}
+}
+
+// Token implements the TokenStore interface.
+func (s *MemoryTokenStore) Token(ctx context.Context, workspaceID string) (*Token, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	token, ok := s.tokens[workspaceID]
+	if !ok {
+		return nil, fmt.Errorf("oauth: no token for workspace %s", workspaceID)
+	}
+	return token, nil
+}
+
+// Put stores the token under its workspace ID, replacing any previous token.
+func (s *MemoryTokenStore) Put(token *Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.tokens[token.WorkspaceID] = token
+}
+
+// Delete removes the token of the workspace.
+func (s *MemoryTokenStore) Delete(workspaceID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tokens, workspaceID)
+}
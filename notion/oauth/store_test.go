@@ -0,0 +1,33 @@
+package oauth
+
+import (
+	"context"
+	"testing"
+)
+
+func TestWorkspaceTokenSource(t *testing.T) {
+	store := NewMemoryTokenStore()
+	ts := WorkspaceTokenSource(store, "workspace")
+
+	if _, err := ts.Token(context.Background()); err == nil {
+		t.Fatalf("expected error for an unknown workspace")
+	}
+
+	for _, token := range []string{"secret_1", "secret_2"} {
+		store.Put(&Token{AccessToken: token, WorkspaceID: "workspace"})
+
+		got, err := ts.Token(context.Background())
+		if err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		if got != token {
+			t.Fatalf("token got:%s want:%s", got, token)
+		}
+	}
+
+	store.Delete("workspace")
+	if _, err := ts.Token(context.Background()); err == nil {
+		t.Fatalf("expected error for a deleted workspace")
+	}
+}
@@ -5,15 +5,27 @@ package object
 type ErrorCode string
 
 const (
-	ErrInvalidJson        ErrorCode = "invalid_json"
-	ErrInvalidRequestURL  ErrorCode = "invalid_request_url"
-	ErrInvalidRequest     ErrorCode = "Invalid_request"
-	ErrValidationErrore   ErrorCode = "validation_error"
-	ErrUnautho            ErrorCode = "invalid_json"
-	ErrRestrictedResource ErrorCode = "restricted_resource"
-	ErrObjectNotFound     ErrorCode = "object_not_found"
-	ErrConflictError      ErrorCode = "invalid_json"
-	ErrRateLimited        ErrorCode = "conflict_error"
-	ErrInternalServer     ErrorCode = "rate_limited"
-	ErrServiceUnavailable ErrorCode = "internal_server_error"
+	ErrInvalidJSON                   ErrorCode = "invalid_json"
+	ErrInvalidRequestURL             ErrorCode = "invalid_request_url"
+	ErrInvalidRequest                ErrorCode = "invalid_request"
+	ErrValidationError               ErrorCode = "validation_error"
+	ErrMissingVersion                ErrorCode = "missing_version"
+	ErrUnauthorized                  ErrorCode = "unauthorized"
+	ErrRestrictedResource            ErrorCode = "restricted_resource"
+	ErrObjectNotFound                ErrorCode = "object_not_found"
+	ErrConflictError                 ErrorCode = "conflict_error"
+	ErrRateLimited                   ErrorCode = "rate_limited"
+	ErrInternalServer                ErrorCode = "internal_server_error"
+	ErrServiceUnavailable            ErrorCode = "service_unavailable"
+	ErrDatabaseConnectionUnavailable ErrorCode = "database_connection_unavailable"
+	ErrGatewayTimeout                ErrorCode = "gateway_timeout"
+)
+
+const (
+	// Deprecated: use ErrInvalidJSON.
+	ErrInvalidJson = ErrInvalidJSON
+	// Deprecated: use ErrValidationError.
+	ErrValidationErrore = ErrValidationError
+	// Deprecated: use ErrUnauthorized.
+	ErrUnautho = ErrUnauthorized
 )
@@ -0,0 +1,86 @@
+package notion
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// CallInfo describes an API call reported to an Observer.
+type CallInfo struct {
+	// Operation is the name of the service method, e.g. "Databases.Query".
+	Operation string
+	// ResourceID is the ID of the resource targeted by the call, if any.
+	ResourceID string
+	Method     string
+	Path       string
+
+	// The fields below are only set when the call ends.
+
+	// StatusCode is the HTTP status code of the last attempt, zero when no response was received.
+	StatusCode int
+	ErrorCode  object.ErrorCode
+	Err        error
+	// Retries is the number of attempts made after the first one.
+	Retries  int
+	Duration time.Duration
+}
+
+// Observer is notified of every API call, e.g. to record traces and metrics.
+type Observer interface {
+	// CallStart is invoked before a call is sent. The returned context is used
+	// for the call, which allows to attach a span to it.
+	CallStart(ctx context.Context, info *CallInfo) context.Context
+	// CallEnd is invoked once the call, including its retries, is finished.
+	CallEnd(ctx context.Context, info *CallInfo)
+}
+
+// WithObserver reports every API call to the observer.
+func WithObserver(observer Observer) ClientOption {
+	return func(c *Client) {
+		c.observer = observer
+	}
+}
+
+// operation identifies the service method issuing a request.
+type operation struct {
+	name       string
+	resourceID string
+}
+
+func newOperation(name, resourceID string) operation {
+	return operation{name: name, resourceID: resourceID}
+}
+
+func (c *Client) startCall(ctx context.Context, op operation, method, path string) (context.Context, *CallInfo) {
+	info := &CallInfo{
+		Operation:  op.name,
+		ResourceID: op.resourceID,
+		Method:     method,
+		Path:       path,
+	}
+
+	if c.observer == nil {
+		return ctx, info
+	}
+
+	return c.observer.CallStart(ctx, info), info
+}
+
+func (c *Client) endCall(ctx context.Context, info *CallInfo, start time.Time, err error) {
+	if c.observer == nil {
+		return
+	}
+
+	info.Duration = time.Since(start)
+	info.Err = err
+
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		info.ErrorCode = apiErr.Code
+	}
+
+	c.observer.CallEnd(ctx, info)
+}
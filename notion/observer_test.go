@@ -0,0 +1,104 @@
+package notion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type ctxKey string
+
+type recordingObserver struct {
+	started []CallInfo
+	ended   []CallInfo
+	spans   []interface{}
+}
+
+func (o *recordingObserver) CallStart(ctx context.Context, info *CallInfo) context.Context {
+	o.started = append(o.started, *info)
+	return context.WithValue(ctx, ctxKey("span"), info.Operation)
+}
+
+func (o *recordingObserver) CallEnd(ctx context.Context, info *CallInfo) {
+	o.ended = append(o.ended, *info)
+	o.spans = append(o.spans, ctx.Value(ctxKey("span")))
+}
+
+func TestWithObserver(t *testing.T) {
+	observer := &recordingObserver{}
+	client, mux, _, teardown := setup(WithObserver(observer), WithRetryPolicy(testRetryPolicy()))
+	defer teardown()
+
+	attempts := 0
+	mux.HandleFunc(fmt.Sprintf("/%s/%s/query", databasesPath, "db"), func(w http.ResponseWriter, r *http.Request) {
+		attempts++
+		if attempts == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"object": "error", "status": 503, "code": "service_unavailable", "message": "unavailable"}`)
+			return
+		}
+		fmt.Fprint(w, `{"object": "list", "results": []}`)
+	})
+
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", usersPath, "missing"), func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"object": "error", "status": 404, "code": "object_not_found", "message": "not found"}`)
+	})
+
+	if _, err := client.Databases.Query(context.Background(), "db", &DatabaseQuery{}); err != nil {
+		t.Fatalf("failed: %v", err)
+	}
+
+	if _, err := client.Users.Get(context.Background(), "missing"); err == nil {
+		t.Fatalf("expected error")
+	}
+
+	want := []CallInfo{
+		{
+			Operation:  "Databases.Query",
+			ResourceID: "db",
+			Method:     http.MethodPost,
+			Path:       "databases/db/query",
+			StatusCode: http.StatusOK,
+			Retries:    1,
+		},
+		{
+			Operation:  "Users.Get",
+			ResourceID: "missing",
+			Method:     http.MethodGet,
+			Path:       "users/missing",
+			StatusCode: http.StatusNotFound,
+			ErrorCode:  "object_not_found",
+		},
+	}
+
+	opts := []cmp.Option{
+		cmpopts.IgnoreFields(CallInfo{}, "Duration", "Err"),
+	}
+	if diff := cmp.Diff(observer.ended, want, opts...); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	for i, info := range observer.ended {
+		if info.Duration <= 0 || info.Duration > time.Minute {
+			t.Fatalf("unexpected duration: %v", info.Duration)
+		}
+
+		if observer.started[i].Operation != info.Operation {
+			t.Fatalf("start and end do not match got:%s want:%s", observer.started[i].Operation, info.Operation)
+		}
+
+		if observer.spans[i] != info.Operation {
+			t.Fatalf("context returned by CallStart not used got:%v", observer.spans[i])
+		}
+	}
+
+	if observer.ended[1].Err == nil {
+		t.Fatalf("error not reported")
+	}
+}
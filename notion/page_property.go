@@ -0,0 +1,266 @@
+package notion
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// ErrPropertyNotFound is returned by the property accessors of Page when the
+// page has no property with the given name or ID.
+var ErrPropertyNotFound = errors.New("property not found")
+
+// PropertyTypeError is returned by the property accessors of Page when the
+// property is not of the type read.
+type PropertyTypeError struct {
+	Property string
+	Want     object.PropertyType
+	Got      object.PropertyType
+}
+
+// Error implements the error interface.
+func (e *PropertyTypeError) Error() string {
+	return fmt.Sprintf("property %q is %s, not %s", e.Property, e.Got, e.Want)
+}
+
+// GetProperty returns the value of the property with the given name or,
+// failing that, the given property ID.
+func (p *Page) GetProperty(key string) (PropertyValue, error) {
+	if key == "" {
+		return nil, fmt.Errorf("%w: empty name", ErrPropertyNotFound)
+	}
+
+	if v, ok := p.Properties[key]; ok && v != nil {
+		return v, nil
+	}
+
+	return p.GetPropertyByID(key)
+}
+
+// GetPropertyByID returns the value of the property with the given ID. Values
+// without ID, e.g. built locally, never match.
+func (p *Page) GetPropertyByID(id string) (PropertyValue, error) {
+	for _, v := range p.Properties {
+		if v == nil {
+			continue
+		}
+		if vid := propertyID(v); vid != "" && vid == id {
+			return v, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: %q", ErrPropertyNotFound, id)
+}
+
+// property looks up a property by name or ID and checks its type.
+func (p *Page) property(key string, want object.PropertyType) (PropertyValue, error) {
+	v, err := p.GetProperty(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.GetType() != want {
+		return nil, &PropertyTypeError{Property: key, Want: want, Got: v.GetType()}
+	}
+
+	return v, nil
+}
+
+// GetTitle returns the plain text of the title property.
+func (p *Page) GetTitle(key string) (string, error) {
+	v, err := p.property(key, object.TitlePropertyType)
+	if err != nil {
+		return "", err
+	}
+
+	return plainText(v.(*TitlePropertyValue).Title), nil
+}
+
+// GetText returns the plain text of a rich text property.
+func (p *Page) GetText(key string) (string, error) {
+	v, err := p.property(key, object.TextPropertyType)
+	if err != nil {
+		return "", err
+	}
+
+	return plainText(v.(*TextPropertyValue).Text), nil
+}
+
+// GetNumber returns the value of a number property, or nil when it is empty.
+func (p *Page) GetNumber(key string) (*float64, error) {
+	v, err := p.property(key, object.NumberPropertyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*NumberPropertyValue).Number, nil
+}
+
+// GetCheckbox returns the value of a checkbox property.
+func (p *Page) GetCheckbox(key string) (bool, error) {
+	v, err := p.property(key, object.CheckboxPropertyType)
+	if err != nil {
+		return false, err
+	}
+
+	return v.(*CheckboxPropertyValue).Checkbox, nil
+}
+
+// GetSelect returns the selected option of a select property, or nil when no
+// option is selected.
+func (p *Page) GetSelect(key string) (*SelectOption, error) {
+	v, err := p.property(key, object.SelectPropertyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*SelectPropertyValue).Select, nil
+}
+
+// GetMultiSelect returns the selected options of a multi select property.
+func (p *Page) GetMultiSelect(key string) ([]MultiSelectOption, error) {
+	v, err := p.property(key, object.MultiSelectPropertyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*MultiSelectPropertyValue).MultiSelect, nil
+}
+
+// GetDate returns the value of a date property, or nil when it is empty.
+func (p *Page) GetDate(key string) (*Date, error) {
+	v, err := p.property(key, object.DatePropertyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*DatePropertyValue).Date, nil
+}
+
+// GetPeople returns the users of a people property.
+func (p *Page) GetPeople(key string) ([]*User, error) {
+	v, err := p.property(key, object.PeoplePropertyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return v.(*PeoplePropertyValue).People, nil
+}
+
+// GetRelationIDs returns the IDs of the pages of a relation property.
+func (p *Page) GetRelationIDs(key string) ([]string, error) {
+	v, err := p.property(key, object.RelationPropertyType)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := []string{}
+	for _, r := range v.(*RelationPropertyValue).Relation {
+		ids = append(ids, r.ID)
+	}
+
+	return ids, nil
+}
+
+// GetURL returns the value of an URL property, or an empty string when it is
+// empty.
+func (p *Page) GetURL(key string) (string, error) {
+	v, err := p.property(key, object.URLPropertyType)
+	if err != nil {
+		return "", err
+	}
+
+	return stringValue(v.(*URLPropertyValue).URL), nil
+}
+
+// GetEmail returns the value of an email property, or an empty string when it
+// is empty.
+func (p *Page) GetEmail(key string) (string, error) {
+	v, err := p.property(key, object.EmailPropertyType)
+	if err != nil {
+		return "", err
+	}
+
+	return stringValue(v.(*EmailPropertyValue).Email), nil
+}
+
+// GetPhoneNumber returns the value of a phone number property, or an empty
+// string when it is empty.
+func (p *Page) GetPhoneNumber(key string) (string, error) {
+	v, err := p.property(key, object.PhoneNumberPropertyType)
+	if err != nil {
+		return "", err
+	}
+
+	return stringValue(v.(*PhoneNumberPropertyValue).PhoneNumber), nil
+}
+
+// plainText concatenates the plain text of rich text objects, falling back to
+// their content when they were not returned by the API.
+func plainText(texts []TextObject) string {
+	var b strings.Builder
+	for _, t := range texts {
+		switch {
+		case t.PlainText != "":
+			b.WriteString(t.PlainText)
+		case t.Text != nil:
+			b.WriteString(t.Text.Content)
+		}
+	}
+	return b.String()
+}
+
+func stringValue(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
+
+// propertyID returns the ID of a property value.
+func propertyID(v PropertyValue) string {
+	switch v := v.(type) {
+	case *TitlePropertyValue:
+		return v.ID
+	case *TextPropertyValue:
+		return v.ID
+	case *NumberPropertyValue:
+		return v.ID
+	case *SelectPropertyValue:
+		return v.ID
+	case *MultiSelectPropertyValue:
+		return v.ID
+	case *DatePropertyValue:
+		return v.ID
+	case *PeoplePropertyValue:
+		return v.ID
+	case *FilesPropertyValue:
+		return v.ID
+	case *CheckboxPropertyValue:
+		return v.ID
+	case *URLPropertyValue:
+		return v.ID
+	case *EmailPropertyValue:
+		return v.ID
+	case *PhoneNumberPropertyValue:
+		return v.ID
+	case *FormulaPropertyValue:
+		return v.ID
+	case *RelationPropertyValue:
+		return v.ID
+	case *RollupPropertyValue:
+		return v.ID
+	case *CreatedTimePropertyValue:
+		return v.ID
+	case *CreatedByPropertyValue:
+		return v.ID
+	case *LastEditedTimePropertyValue:
+		return v.ID
+	case *LastEditedByPropertyValue:
+		return v.ID
+	default:
+		return ""
+	}
+}
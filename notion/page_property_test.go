@@ -0,0 +1,145 @@
+package notion
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+func testPage() *Page {
+	price, url := 2.5, "https://example.com"
+	return &Page{
+		Properties: map[string]PropertyValue{
+			"Name": &TitlePropertyValue{Type: object.TitlePropertyType, ID: "title", Title: []TextObject{
+				{PlainText: "Avo", Text: &Text{Content: "Avo"}},
+				{Text: &Text{Content: "cado"}},
+			}},
+			"Price":    &NumberPropertyValue{Type: object.NumberPropertyType, ID: "cU^N", Number: &price},
+			"Stock":    &NumberPropertyValue{Type: object.NumberPropertyType, ID: "stck"},
+			"In stock": &CheckboxPropertyValue{Type: object.CheckboxPropertyType, ID: "{xY", Checkbox: true},
+			"Food group": &SelectPropertyValue{Type: object.SelectPropertyType, ID: "TJmr",
+				Select: &SelectOption{ID: "bb443819", Name: "Fruit", Color: RedColor},
+			},
+			"Last ordered": &DatePropertyValue{Type: object.DatePropertyType, ID: "]\\R[", Date: &Date{Start: "2021-05-10"}},
+			"Meals":        &RelationPropertyValue{Type: object.RelationPropertyType, ID: "mxp^", Relation: []PageReference{{ID: "p1"}, {ID: "p2"}}},
+			"+1":           &PeoplePropertyValue{Type: object.PeoplePropertyType, ID: "aGut", People: []*User{{ID: "u1"}}},
+			"Website":      &URLPropertyValue{Type: object.URLPropertyType, ID: "url", URL: &url},
+		},
+	}
+}
+
+func TestPage_accessors(t *testing.T) {
+	p := testPage()
+
+	title, err := p.GetTitle("Name")
+	if err != nil || title != "Avocado" {
+		t.Fatalf("GetTitle got:%q, %v want:Avocado", title, err)
+	}
+
+	price, err := p.GetNumber("Price")
+	if err != nil || price == nil || *price != 2.5 {
+		t.Fatalf("GetNumber got:%v, %v want:2.5", price, err)
+	}
+
+	stock, err := p.GetNumber("Stock")
+	if err != nil || stock != nil {
+		t.Fatalf("GetNumber of empty number got:%v, %v want:nil", stock, err)
+	}
+
+	inStock, err := p.GetCheckbox("In stock")
+	if err != nil || !inStock {
+		t.Fatalf("GetCheckbox got:%v, %v want:true", inStock, err)
+	}
+
+	group, err := p.GetSelect("Food group")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if diff := cmp.Diff(group, &SelectOption{ID: "bb443819", Name: "Fruit", Color: RedColor}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	date, err := p.GetDate("Last ordered")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if diff := cmp.Diff(date, &Date{Start: "2021-05-10"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	ids, err := p.GetRelationIDs("Meals")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if diff := cmp.Diff(ids, []string{"p1", "p2"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	people, err := p.GetPeople("+1")
+	if err != nil || len(people) != 1 || people[0].ID != "u1" {
+		t.Fatalf("GetPeople got:%v, %v", people, err)
+	}
+
+	url, err := p.GetURL("Website")
+	if err != nil || url != "https://example.com" {
+		t.Fatalf("GetURL got:%q, %v", url, err)
+	}
+}
+
+func TestPage_GetProperty_byID(t *testing.T) {
+	p := testPage()
+
+	price, err := p.GetNumber("cU^N")
+	if err != nil || price == nil || *price != 2.5 {
+		t.Fatalf("GetNumber by ID got:%v, %v want:2.5", price, err)
+	}
+
+	v, err := p.GetPropertyByID("TJmr")
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if v.GetType() != object.SelectPropertyType {
+		t.Fatalf("type got:%s want:%s", v.GetType(), object.SelectPropertyType)
+	}
+}
+
+func TestPage_GetProperty_errors(t *testing.T) {
+	p := testPage()
+
+	if _, err := p.GetCheckbox("Missing"); !errors.Is(err, ErrPropertyNotFound) {
+		t.Fatalf("expected not found got:%v", err)
+	}
+
+	_, err := p.GetCheckbox("Price")
+	var typeErr *PropertyTypeError
+	if !errors.As(err, &typeErr) {
+		t.Fatalf("expected type error got:%v", err)
+	}
+
+	want := &PropertyTypeError{Property: "Price", Want: object.CheckboxPropertyType, Got: object.NumberPropertyType}
+	if diff := cmp.Diff(typeErr, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if typeErr.Error() != `property "Price" is number, not checkbox` {
+		t.Fatalf("unexpected message: %s", typeErr)
+	}
+}
+
+func TestPage_GetProperty_withoutID(t *testing.T) {
+	p := &Page{Properties: map[string]PropertyValue{
+		"Done": &CheckboxPropertyValue{Type: object.CheckboxPropertyType, Checkbox: true},
+	}}
+
+	for _, key := range []string{"", "Misspelled"} {
+		if _, err := p.GetProperty(key); !errors.Is(err, ErrPropertyNotFound) {
+			t.Fatalf("GetProperty(%q) expected not found got:%v", key, err)
+		}
+	}
+
+	if _, err := p.GetPropertyByID(""); !errors.Is(err, ErrPropertyNotFound) {
+		t.Fatalf("GetPropertyByID(\"\") expected not found got:%v", err)
+	}
+}
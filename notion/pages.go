@@ -24,12 +24,13 @@ type PagesService service
 // API doc: https://developers.notion.com/reference/get-page
 //go:generate gomodifytags --file $GOFILE --struct Page -add-tags json,mapstructure -w -transform snakecase
 type Page struct {
-	Object         object.Type         `json:"object" mapstructure:"object"`
-	ID             string              `json:"id" mapstructure:"id"`
-	CreatedTime    string              `json:"created_time" mapstructure:"created_time"`
-	LastEditedTime string              `json:"last_edited_time" mapstructure:"last_edited_time"`
-	Parent         Parent              `json:"parent" mapstructure:"parent"`
-	Properties     map[string]Property `json:"properties" mapstructure:"properties"`
+	Object         object.Type              `json:"object" mapstructure:"object"`
+	ID             string                   `json:"id" mapstructure:"id"`
+	CreatedTime    string                   `json:"created_time" mapstructure:"created_time"`
+	LastEditedTime string                   `json:"last_edited_time" mapstructure:"last_edited_time"`
+	Parent         Parent                   `json:"parent" mapstructure:"parent"`
+	Archived       bool                     `json:"archived" mapstructure:"archived"`
+	Properties     map[string]PropertyValue `json:"properties" mapstructure:"properties"`
 }
 
 func (p *Page) GetObject() object.Type {
@@ -42,6 +43,7 @@ type page struct {
 	CreatedTime    string                 `json:"created_time"`
 	LastEditedTime string                 `json:"last_edited_time"`
 	Parent         map[string]interface{} `json:"parent"`
+	Archived       bool                   `json:"archived"`
 	Properties     map[string]interface{} `json:"properties"`
 }
 
@@ -92,8 +94,8 @@ func (p *WorkspaceParent) GetType() object.ParentType {
 // Get retrieves a page.
 //
 // API doc: https://developers.notion.com/reference/get-page
-func (s *PagesService) Get(ctx context.Context, pageID string) (*Page, error) {
-	resp, err := s.client.get(ctx, fmt.Sprintf("%s/%s", pagesPath, pageID))
+func (s *PagesService) Get(ctx context.Context, pageID string, opts ...RequestOption) (*Page, error) {
+	resp, err := s.client.get(ctx, newOperation("Pages.Get", pageID), fmt.Sprintf("%s/%s", pagesPath, pageID), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -110,16 +112,16 @@ func (s *PagesService) Get(ctx context.Context, pageID string) (*Page, error) {
 // CreatePageRequest object represents the retrieve page.
 //go:generate gomodifytags --file $GOFILE --struct CreatePageRequest -add-tags json,mapstructure -w -transform snakecase
 type CreatePageRequest struct {
-	Parent     Parent              `json:"parent" mapstructure:"parent"`
-	Properties map[string]Property `json:"properties" mapstructure:"properties"`
-	Children   []Block             `json:"children,omitempty" mapstructure:"children"`
+	Parent     Parent                   `json:"parent" mapstructure:"parent"`
+	Properties map[string]PropertyValue `json:"properties" mapstructure:"properties"`
+	Children   []Block                  `json:"children,omitempty" mapstructure:"children"`
 }
 
 // Create page.
 //
 // API doc: https://developers.notion.com/reference/post-page
-func (s *PagesService) Create(ctx context.Context, preq *CreatePageRequest) (*Page, error) {
-	resp, err := s.client.post(ctx, pagesPath, preq)
+func (s *PagesService) Create(ctx context.Context, preq *CreatePageRequest, opts ...RequestOption) (*Page, error) {
+	resp, err := s.client.post(ctx, newOperation("Pages.Create", ""), pagesPath, preq, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -133,16 +135,18 @@ func (s *PagesService) Create(ctx context.Context, preq *CreatePageRequest) (*Pa
 	return convPage(&data)
 }
 
-// UpdatePageRequest object represents the update request
+// UpdatePageRequest object represents the update request. Setting Archived
+// to true archives the page, and to false restores it.
 type UpdatePageRequest struct {
-	Properties map[string]Property `json:"properties" mapstructure:"properties"`
+	Properties map[string]PropertyValue `json:"properties,omitempty" mapstructure:"properties"`
+	Archived   *bool                    `json:"archived,omitempty" mapstructure:"archived"`
 }
 
 // UpdateProperties page properties.
 //
 // API doc: https://developers.notion.com/reference/patch-page
-func (s *PagesService) UpdateProperties(ctx context.Context, pageID string, ureq *UpdatePageRequest) (*Page, error) {
-	resp, err := s.client.patch(ctx, fmt.Sprintf("%s/%s", pagesPath, pageID), ureq)
+func (s *PagesService) UpdateProperties(ctx context.Context, pageID string, ureq *UpdatePageRequest, opts ...RequestOption) (*Page, error) {
+	resp, err := s.client.patch(ctx, newOperation("Pages.UpdateProperties", pageID), fmt.Sprintf("%s/%s", pagesPath, pageID), ureq, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -173,7 +177,7 @@ func convPage(data *page) (*Page, error) {
 		return nil, err
 	}
 
-	properties, err := convProperties(data.Properties)
+	properties, err := convPropertyValues(data.Properties)
 	if err != nil {
 		return nil, err
 	}
@@ -185,6 +189,7 @@ func convPage(data *page) (*Page, error) {
 		LastEditedTime: data.LastEditedTime,
 		Properties:     properties,
 		Parent:         p,
+		Archived:       data.Archived,
 	}
 
 	return page, nil
@@ -319,9 +319,9 @@ func TestPagesService_UpdateProperties(t *testing.T) {
 					Type:       object.DatabaseParentType,
 					DatabaseID: "48f8fee9-cd79-4180-bc2f-ec0398253067",
 				},
-				Properties: map[string]Property{
-					"In stock": &CheckboxProperty{Type: "checkbox", ID: "{>U;", Checkbox: true},
-					"Name": &PageTitleProperty{Type: "title", ID: "title", Title: []TextObject{
+				Properties: map[string]PropertyValue{
+					"In stock": &CheckboxPropertyValue{Type: "checkbox", ID: "{>U;", Checkbox: true},
+					"Name": &TitlePropertyValue{Type: "title", ID: "title", Title: []TextObject{
 						{
 							PlainText:   "Avocado",
 							Annotations: &Annotations{Color: "default"},
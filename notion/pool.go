@@ -0,0 +1,91 @@
+package notion
+
+import (
+	"sync"
+	"time"
+)
+
+// ClientPool lazily creates and caches one Client per workspace, so that the
+// rate limit state of a workspace survives across requests.
+//
+// Clients of a pool share the same http.Client and therefore its connections,
+// but each one keeps its own rate limit state and limiter unless a shared
+// Limiter is given with WithLimiter. A ClientPool is safe for concurrent use.
+type ClientPool struct {
+	mu          sync.Mutex
+	clients     map[string]*pooledClient
+	opts        []ClientOption
+	idleTimeout time.Duration
+	lastSweep   time.Time
+	now         func() time.Time
+}
+
+type pooledClient struct {
+	client   *Client
+	token    string
+	lastUsed time.Time
+}
+
+// NewClientPool returns a ClientPool creating its clients with the options.
+// Clients unused for longer than idleTimeout are evicted, a zero idleTimeout
+// keeps them forever.
+func NewClientPool(idleTimeout time.Duration, opts ...ClientOption) *ClientPool {
+	return &ClientPool{
+		clients:     map[string]*pooledClient{},
+		opts:        opts,
+		idleTimeout: idleTimeout,
+		now:         time.Now,
+	}
+}
+
+// Client returns the client of the workspace, creating it when needed.
+// A new client is created when the token of the workspace has changed.
+func (p *ClientPool) Client(workspaceID, token string) *Client {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	p.sweep(now)
+
+	pc, ok := p.clients[workspaceID]
+	if !ok || pc.token != token {
+		pc = &pooledClient{
+			client: NewClient(token, p.opts...),
+			token:  token,
+		}
+		p.clients[workspaceID] = pc
+	}
+	pc.lastUsed = now
+
+	return pc.client
+}
+
+// Evict removes the client of the workspace from the pool.
+func (p *ClientPool) Evict(workspaceID string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	delete(p.clients, workspaceID)
+}
+
+// Len returns the number of clients in the pool.
+func (p *ClientPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return len(p.clients)
+}
+
+// sweep evicts idle clients, at most once per idle timeout.
+func (p *ClientPool) sweep(now time.Time) {
+	if p.idleTimeout <= 0 || now.Sub(p.lastSweep) < p.idleTimeout {
+		return
+	}
+	p.lastSweep = now
+
+	for id, pc := range p.clients {
+		if now.Sub(pc.lastUsed) > p.idleTimeout {
+			delete(p.clients, id)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package notion
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestClientPool_Client(t *testing.T) {
+	p := NewClientPool(time.Minute, WithLimiter(nil))
+
+	a := p.Client("workspace-a", "secret_a")
+	if got := p.Client("workspace-a", "secret_a"); got != a {
+		t.Fatalf("client of the workspace has not been cached")
+	}
+
+	b := p.Client("workspace-b", "secret_b")
+	if b == a {
+		t.Fatalf("workspaces share the same client")
+	}
+
+	if b.RateLimit == a.RateLimit {
+		t.Fatalf("workspaces share the same rate limit state")
+	}
+
+	if got := p.Client("workspace-a", "secret_a2"); got == a {
+		t.Fatalf("client has not been renewed after the token changed")
+	}
+
+	if got := p.Len(); got != 2 {
+		t.Fatalf("len got:%d want:2", got)
+	}
+
+	p.Evict("workspace-b")
+	if got := p.Len(); got != 1 {
+		t.Fatalf("len got:%d want:1", got)
+	}
+}
+
+func TestClientPool_idle(t *testing.T) {
+	now := time.Unix(1620000000, 0)
+	p := NewClientPool(time.Minute)
+	p.now = func() time.Time { return now }
+
+	p.Client("idle", "secret_idle")
+	p.Client("active", "secret_active")
+
+	now = now.Add(45 * time.Second)
+	p.Client("active", "secret_active")
+
+	now = now.Add(45 * time.Second)
+	p.Client("active", "secret_active")
+
+	if got := p.Len(); got != 1 {
+		t.Fatalf("idle client has not been evicted len got:%d want:1", got)
+	}
+}
+
+func TestClientPool_concurrent(t *testing.T) {
+	p := NewClientPool(time.Minute)
+
+	var wg sync.WaitGroup
+	clients := make([]*Client, 50)
+	for i := range clients {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			clients[i] = p.Client("workspace", "secret")
+		}(i)
+	}
+	wg.Wait()
+
+	for _, c := range clients {
+		if c != clients[0] {
+			t.Fatalf("concurrent calls created several clients")
+		}
+	}
+}
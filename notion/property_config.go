@@ -0,0 +1,398 @@
+package notion
+
+import (
+	"fmt"
+
+	"github.com/ketion-so/go-notion/notion/object"
+	"github.com/mitchellh/mapstructure"
+)
+
+// PropertyConfig represents the schema of a database property.
+//
+// API doc: https://developers.notion.com/reference/database#database-properties
+type PropertyConfig interface {
+	GetType() object.PropertyType
+}
+
+// TitlePropertyConfig object represents the schema of Notion title property.
+//go:generate gomodifytags --file $GOFILE --struct TitlePropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type TitlePropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *TitlePropertyConfig) GetType() object.PropertyType {
+	return object.TitlePropertyType
+}
+
+// TextPropertyConfig object represents the schema of Notion rich text property.
+//go:generate gomodifytags --file $GOFILE --struct TextPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type TextPropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *TextPropertyConfig) GetType() object.PropertyType {
+	return object.TextPropertyType
+}
+
+// NumberPropertyConfig object represents the schema of Notion number property.
+//go:generate gomodifytags --file $GOFILE --struct NumberPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type NumberPropertyConfig struct {
+	Type   object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID     string              `json:"id,omitempty" mapstructure:"id"`
+	Format string              `json:"format,omitempty" mapstructure:"format"`
+}
+
+// GetType returns the type of the property.
+func (p *NumberPropertyConfig) GetType() object.PropertyType {
+	return object.NumberPropertyType
+}
+
+// SelectPropertyConfig object represents the schema of Notion select property.
+//go:generate gomodifytags --file $GOFILE --struct SelectPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type SelectPropertyConfig struct {
+	Type    object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID      string              `json:"id,omitempty" mapstructure:"id"`
+	Options []SelectOption      `json:"options" mapstructure:"options"`
+}
+
+// GetType returns the type of the property.
+func (p *SelectPropertyConfig) GetType() object.PropertyType {
+	return object.SelectPropertyType
+}
+
+// SelectOption object represents an option of Notion select property.
+//go:generate gomodifytags --file $GOFILE --struct SelectOption -add-tags json,mapstructure -w -transform snakecase
+type SelectOption struct {
+	Name  string `json:"name,omitempty" mapstructure:"name"`
+	ID    string `json:"id,omitempty" mapstructure:"id"`
+	Color Color  `json:"color,omitempty" mapstructure:"color"`
+}
+
+// MultiSelectPropertyConfig object represents the schema of Notion multi select property.
+//go:generate gomodifytags --file $GOFILE --struct MultiSelectPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type MultiSelectPropertyConfig struct {
+	Type    object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID      string              `json:"id,omitempty" mapstructure:"id"`
+	Options []MultiSelectOption `json:"options" mapstructure:"options"`
+}
+
+// GetType returns the type of the property.
+func (p *MultiSelectPropertyConfig) GetType() object.PropertyType {
+	return object.MultiSelectPropertyType
+}
+
+// MultiSelectOption object represents an option of Notion multi select property.
+//go:generate gomodifytags --file $GOFILE --struct MultiSelectOption -add-tags json,mapstructure -w -transform snakecase
+type MultiSelectOption struct {
+	Name  string `json:"name,omitempty" mapstructure:"name"`
+	ID    string `json:"id,omitempty" mapstructure:"id"`
+	Color Color  `json:"color,omitempty" mapstructure:"color"`
+}
+
+// DatePropertyConfig object represents the schema of Notion date property.
+//go:generate gomodifytags --file $GOFILE --struct DatePropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type DatePropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *DatePropertyConfig) GetType() object.PropertyType {
+	return object.DatePropertyType
+}
+
+// PeoplePropertyConfig object represents the schema of Notion people property.
+//go:generate gomodifytags --file $GOFILE --struct PeoplePropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type PeoplePropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *PeoplePropertyConfig) GetType() object.PropertyType {
+	return object.PeoplePropertyType
+}
+
+// FilesPropertyConfig object represents the schema of Notion files property.
+//go:generate gomodifytags --file $GOFILE --struct FilesPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type FilesPropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *FilesPropertyConfig) GetType() object.PropertyType {
+	return object.FilesPropertyType
+}
+
+// CheckboxPropertyConfig object represents the schema of Notion checkbox property.
+//go:generate gomodifytags --file $GOFILE --struct CheckboxPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type CheckboxPropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *CheckboxPropertyConfig) GetType() object.PropertyType {
+	return object.CheckboxPropertyType
+}
+
+// URLPropertyConfig object represents the schema of Notion URL property.
+//go:generate gomodifytags --file $GOFILE --struct URLPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type URLPropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *URLPropertyConfig) GetType() object.PropertyType {
+	return object.URLPropertyType
+}
+
+// EmailPropertyConfig object represents the schema of Notion email property.
+//go:generate gomodifytags --file $GOFILE --struct EmailPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type EmailPropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *EmailPropertyConfig) GetType() object.PropertyType {
+	return object.EmailPropertyType
+}
+
+// PhoneNumberPropertyConfig object represents the schema of Notion phone number property.
+//go:generate gomodifytags --file $GOFILE --struct PhoneNumberPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type PhoneNumberPropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *PhoneNumberPropertyConfig) GetType() object.PropertyType {
+	return object.PhoneNumberPropertyType
+}
+
+// FormulaPropertyConfig object represents the schema of Notion formula property.
+//go:generate gomodifytags --file $GOFILE --struct FormulaPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type FormulaPropertyConfig struct {
+	Type       object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID         string              `json:"id,omitempty" mapstructure:"id"`
+	Expression string              `json:"expression,omitempty" mapstructure:"expression"`
+}
+
+// GetType returns the type of the property.
+func (p *FormulaPropertyConfig) GetType() object.PropertyType {
+	return object.FormulaPropertyType
+}
+
+// RelationPropertyConfig object represents the schema of Notion relation property.
+//go:generate gomodifytags --file $GOFILE --struct RelationPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type RelationPropertyConfig struct {
+	Type               object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID                 string              `json:"id,omitempty" mapstructure:"id"`
+	DatabaseID         string              `json:"database_id,omitempty" mapstructure:"database_id"`
+	SyncedPropertyName string              `json:"synced_property_name,omitempty" mapstructure:"synced_property_name"`
+	SyncedPropertyID   string              `json:"synced_property_id,omitempty" mapstructure:"synced_property_id"`
+}
+
+// GetType returns the type of the property.
+func (p *RelationPropertyConfig) GetType() object.PropertyType {
+	return object.RelationPropertyType
+}
+
+// RollupPropertyConfig object represents the schema of Notion rollup property.
+//go:generate gomodifytags --file $GOFILE --struct RollupPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type RollupPropertyConfig struct {
+	Type                 object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID                   string              `json:"id,omitempty" mapstructure:"id"`
+	RelationPropertyName string              `json:"relation_property_name,omitempty" mapstructure:"relation_property_name"`
+	RelationPropertyID   string              `json:"relation_property_id,omitempty" mapstructure:"relation_property_id"`
+	RollupPropertyName   string              `json:"rollup_property_name,omitempty" mapstructure:"rollup_property_name"`
+	RollupPropertyID     string              `json:"rollup_property_id,omitempty" mapstructure:"rollup_property_id"`
+	Function             string              `json:"function,omitempty" mapstructure:"function"`
+}
+
+// GetType returns the type of the property.
+func (p *RollupPropertyConfig) GetType() object.PropertyType {
+	return object.RollupPropertyType
+}
+
+// CreatedTimePropertyConfig object represents the schema of Notion created time property.
+//go:generate gomodifytags --file $GOFILE --struct CreatedTimePropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type CreatedTimePropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *CreatedTimePropertyConfig) GetType() object.PropertyType {
+	return object.CreatedTimePropertyType
+}
+
+// CreatedByPropertyConfig object represents the schema of Notion created by property.
+//go:generate gomodifytags --file $GOFILE --struct CreatedByPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type CreatedByPropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *CreatedByPropertyConfig) GetType() object.PropertyType {
+	return object.CreatedByPropertyType
+}
+
+// LastEditedTimePropertyConfig object represents the schema of Notion last edited time property.
+//go:generate gomodifytags --file $GOFILE --struct LastEditedTimePropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type LastEditedTimePropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *LastEditedTimePropertyConfig) GetType() object.PropertyType {
+	return object.LastEditedTimePropertyType
+}
+
+// LastEditedByPropertyConfig object represents the schema of Notion last edited by property.
+//go:generate gomodifytags --file $GOFILE --struct LastEditedByPropertyConfig -add-tags json,mapstructure -w -transform snakecase
+type LastEditedByPropertyConfig struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+}
+
+// GetType returns the type of the property.
+func (p *LastEditedByPropertyConfig) GetType() object.PropertyType {
+	return object.LastEditedByPropertyType
+}
+
+// newPropertyConfig returns the empty schema of the property type.
+func newPropertyConfig(typ object.PropertyType) (PropertyConfig, error) {
+	switch typ {
+	case object.TitlePropertyType:
+		return &TitlePropertyConfig{}, nil
+	case object.TextPropertyType:
+		return &TextPropertyConfig{}, nil
+	case object.NumberPropertyType:
+		return &NumberPropertyConfig{}, nil
+	case object.SelectPropertyType:
+		return &SelectPropertyConfig{}, nil
+	case object.MultiSelectPropertyType:
+		return &MultiSelectPropertyConfig{}, nil
+	case object.DatePropertyType:
+		return &DatePropertyConfig{}, nil
+	case object.PeoplePropertyType:
+		return &PeoplePropertyConfig{}, nil
+	case object.FilesPropertyType:
+		return &FilesPropertyConfig{}, nil
+	case object.CheckboxPropertyType:
+		return &CheckboxPropertyConfig{}, nil
+	case object.URLPropertyType:
+		return &URLPropertyConfig{}, nil
+	case object.EmailPropertyType:
+		return &EmailPropertyConfig{}, nil
+	case object.PhoneNumberPropertyType:
+		return &PhoneNumberPropertyConfig{}, nil
+	case object.FormulaPropertyType:
+		return &FormulaPropertyConfig{}, nil
+	case object.RelationPropertyType:
+		return &RelationPropertyConfig{}, nil
+	case object.RollupPropertyType:
+		return &RollupPropertyConfig{}, nil
+	case object.CreatedTimePropertyType:
+		return &CreatedTimePropertyConfig{}, nil
+	case object.CreatedByPropertyType:
+		return &CreatedByPropertyConfig{}, nil
+	case object.LastEditedTimePropertyType:
+		return &LastEditedTimePropertyConfig{}, nil
+	case object.LastEditedByPropertyType:
+		return &LastEditedByPropertyConfig{}, nil
+	default:
+		return nil, fmt.Errorf("%v type is not supported property type", typ)
+	}
+}
+
+// convPropertyConfigs decodes the property schema of a database. The API nests
+// the configuration of each property under its type, e.g. the options of a
+// select, which are moved to the fields of the matching PropertyConfig.
+func convPropertyConfigs(input map[string]interface{}) (map[string]PropertyConfig, error) {
+	properties := map[string]PropertyConfig{}
+	for k, v := range input {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		typ, _ := obj["type"].(string)
+		p, err := newPropertyConfig(object.PropertyType(typ))
+		if err != nil {
+			return nil, err
+		}
+
+		flat := map[string]interface{}{}
+		if config, ok := obj[typ].(map[string]interface{}); ok {
+			for key, c := range config {
+				flat[key] = c
+			}
+		}
+		flat["type"], flat["id"] = obj["type"], obj["id"]
+
+		if err := mapstructure.Decode(flat, p); err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+
+		properties[k] = p
+	}
+
+	return properties, nil
+}
+
+// propertyConfigID returns the ID of the property, empty when it is unknown.
+func propertyConfigID(p PropertyConfig) string {
+	switch p := p.(type) {
+	case *TitlePropertyConfig:
+		return p.ID
+	case *TextPropertyConfig:
+		return p.ID
+	case *NumberPropertyConfig:
+		return p.ID
+	case *SelectPropertyConfig:
+		return p.ID
+	case *MultiSelectPropertyConfig:
+		return p.ID
+	case *DatePropertyConfig:
+		return p.ID
+	case *PeoplePropertyConfig:
+		return p.ID
+	case *FilesPropertyConfig:
+		return p.ID
+	case *CheckboxPropertyConfig:
+		return p.ID
+	case *URLPropertyConfig:
+		return p.ID
+	case *EmailPropertyConfig:
+		return p.ID
+	case *PhoneNumberPropertyConfig:
+		return p.ID
+	case *FormulaPropertyConfig:
+		return p.ID
+	case *RelationPropertyConfig:
+		return p.ID
+	case *RollupPropertyConfig:
+		return p.ID
+	case *CreatedTimePropertyConfig:
+		return p.ID
+	case *CreatedByPropertyConfig:
+		return p.ID
+	case *LastEditedTimePropertyConfig:
+		return p.ID
+	case *LastEditedByPropertyConfig:
+		return p.ID
+	default:
+		return ""
+	}
+}
@@ -0,0 +1,420 @@
+package notion
+
+import (
+	"fmt"
+
+	"github.com/ketion-so/go-notion/notion/object"
+	"github.com/mitchellh/mapstructure"
+)
+
+// PropertyValue represents the value of a page property.
+//
+// API doc: https://developers.notion.com/reference/page#property-value-object
+type PropertyValue interface {
+	GetType() object.PropertyType
+}
+
+// TitlePropertyValue object represents the value of Notion title property.
+//go:generate gomodifytags --file $GOFILE --struct TitlePropertyValue -add-tags json,mapstructure -w -transform snakecase
+type TitlePropertyValue struct {
+	Type  object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID    string              `json:"id,omitempty" mapstructure:"id"`
+	Title []TextObject        `json:"title" mapstructure:"title"`
+}
+
+// GetType returns the type of the property.
+func (p *TitlePropertyValue) GetType() object.PropertyType {
+	return object.TitlePropertyType
+}
+
+// TextPropertyValue object represents the value of Notion rich text property.
+//go:generate gomodifytags --file $GOFILE --struct TextPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type TextPropertyValue struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+	Text []TextObject        `json:"text" mapstructure:"text"`
+}
+
+// GetType returns the type of the property.
+func (p *TextPropertyValue) GetType() object.PropertyType {
+	return object.TextPropertyType
+}
+
+// NumberPropertyValue object represents the value of Notion number property.
+// Number is nil when the property is empty.
+//go:generate gomodifytags --file $GOFILE --struct NumberPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type NumberPropertyValue struct {
+	Type   object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID     string              `json:"id,omitempty" mapstructure:"id"`
+	Number *float64            `json:"number" mapstructure:"number"`
+}
+
+// GetType returns the type of the property.
+func (p *NumberPropertyValue) GetType() object.PropertyType {
+	return object.NumberPropertyType
+}
+
+// SelectPropertyValue object represents the value of Notion select property.
+// Select is nil when no option is selected.
+//go:generate gomodifytags --file $GOFILE --struct SelectPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type SelectPropertyValue struct {
+	Type   object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID     string              `json:"id,omitempty" mapstructure:"id"`
+	Select *SelectOption       `json:"select" mapstructure:"select"`
+}
+
+// GetType returns the type of the property.
+func (p *SelectPropertyValue) GetType() object.PropertyType {
+	return object.SelectPropertyType
+}
+
+// MultiSelectPropertyValue object represents the value of Notion multi select property.
+//go:generate gomodifytags --file $GOFILE --struct MultiSelectPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type MultiSelectPropertyValue struct {
+	Type        object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID          string              `json:"id,omitempty" mapstructure:"id"`
+	MultiSelect []MultiSelectOption `json:"multi_select" mapstructure:"multi_select"`
+}
+
+// GetType returns the type of the property.
+func (p *MultiSelectPropertyValue) GetType() object.PropertyType {
+	return object.MultiSelectPropertyType
+}
+
+// DatePropertyValue object represents the value of Notion date property.
+// Date is nil when the property is empty.
+//go:generate gomodifytags --file $GOFILE --struct DatePropertyValue -add-tags json,mapstructure -w -transform snakecase
+type DatePropertyValue struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+	Date *Date               `json:"date" mapstructure:"date"`
+}
+
+// GetType returns the type of the property.
+func (p *DatePropertyValue) GetType() object.PropertyType {
+	return object.DatePropertyType
+}
+
+// Date represents data object's date
+type Date struct {
+	Start string `json:"start" mapstructure:"start"`
+	End   string `json:"end,omitempty" mapstructure:"end"`
+}
+
+// PeoplePropertyValue object represents the value of Notion people property.
+//go:generate gomodifytags --file $GOFILE --struct PeoplePropertyValue -add-tags json,mapstructure -w -transform snakecase
+type PeoplePropertyValue struct {
+	Type   object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID     string              `json:"id,omitempty" mapstructure:"id"`
+	People []*User             `json:"people" mapstructure:"people"`
+}
+
+// GetType returns the type of the property.
+func (p *PeoplePropertyValue) GetType() object.PropertyType {
+	return object.PeoplePropertyType
+}
+
+// FilesPropertyValue object represents the value of Notion files property.
+//go:generate gomodifytags --file $GOFILE --struct FilesPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type FilesPropertyValue struct {
+	Type  object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID    string              `json:"id,omitempty" mapstructure:"id"`
+	Files []File              `json:"files" mapstructure:"files"`
+}
+
+// GetType returns the type of the property.
+func (p *FilesPropertyValue) GetType() object.PropertyType {
+	return object.FilesPropertyType
+}
+
+// File object represents a file of Notion files property, either hosted by
+// Notion or external.
+//go:generate gomodifytags --file $GOFILE --struct File -add-tags json,mapstructure -w -transform snakecase
+type File struct {
+	Name     string    `json:"name" mapstructure:"name"`
+	Type     string    `json:"type,omitempty" mapstructure:"type"`
+	File     *FileLink `json:"file,omitempty" mapstructure:"file"`
+	External *FileLink `json:"external,omitempty" mapstructure:"external"`
+}
+
+// FileLink object represents the link to a file.
+//go:generate gomodifytags --file $GOFILE --struct FileLink -add-tags json,mapstructure -w -transform snakecase
+type FileLink struct {
+	URL        string `json:"url" mapstructure:"url"`
+	ExpiryTime string `json:"expiry_time,omitempty" mapstructure:"expiry_time"`
+}
+
+// CheckboxPropertyValue object represents the value of Notion checkbox property.
+//go:generate gomodifytags --file $GOFILE --struct CheckboxPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type CheckboxPropertyValue struct {
+	Type     object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID       string              `json:"id,omitempty" mapstructure:"id"`
+	Checkbox bool                `json:"checkbox" mapstructure:"checkbox"`
+}
+
+// GetType returns the type of the property.
+func (p *CheckboxPropertyValue) GetType() object.PropertyType {
+	return object.CheckboxPropertyType
+}
+
+// URLPropertyValue object represents the value of Notion URL property.
+// URL is nil when the property is empty.
+//go:generate gomodifytags --file $GOFILE --struct URLPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type URLPropertyValue struct {
+	Type object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID   string              `json:"id,omitempty" mapstructure:"id"`
+	URL  *string             `json:"url" mapstructure:"url"`
+}
+
+// GetType returns the type of the property.
+func (p *URLPropertyValue) GetType() object.PropertyType {
+	return object.URLPropertyType
+}
+
+// EmailPropertyValue object represents the value of Notion email property.
+// Email is nil when the property is empty.
+//go:generate gomodifytags --file $GOFILE --struct EmailPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type EmailPropertyValue struct {
+	Type  object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID    string              `json:"id,omitempty" mapstructure:"id"`
+	Email *string             `json:"email" mapstructure:"email"`
+}
+
+// GetType returns the type of the property.
+func (p *EmailPropertyValue) GetType() object.PropertyType {
+	return object.EmailPropertyType
+}
+
+// PhoneNumberPropertyValue object represents the value of Notion phone number property.
+// PhoneNumber is nil when the property is empty.
+//go:generate gomodifytags --file $GOFILE --struct PhoneNumberPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type PhoneNumberPropertyValue struct {
+	Type        object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID          string              `json:"id,omitempty" mapstructure:"id"`
+	PhoneNumber *string             `json:"phone_number" mapstructure:"phone_number"`
+}
+
+// GetType returns the type of the property.
+func (p *PhoneNumberPropertyValue) GetType() object.PropertyType {
+	return object.PhoneNumberPropertyType
+}
+
+// FormulaPropertyValue object represents the computed value of Notion formula
+// property. It can't be written.
+//go:generate gomodifytags --file $GOFILE --struct FormulaPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type FormulaPropertyValue struct {
+	Type    object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID      string              `json:"id,omitempty" mapstructure:"id"`
+	Formula *FormulaValue       `json:"formula,omitempty" mapstructure:"formula"`
+}
+
+// GetType returns the type of the property.
+func (p *FormulaPropertyValue) GetType() object.PropertyType {
+	return object.FormulaPropertyType
+}
+
+// FormulaValue object represents the result of a formula, set according to
+// its type: string, number, boolean or date.
+//go:generate gomodifytags --file $GOFILE --struct FormulaValue -add-tags json,mapstructure -w -transform snakecase
+type FormulaValue struct {
+	Type    string   `json:"type" mapstructure:"type"`
+	String  *string  `json:"string,omitempty" mapstructure:"string"`
+	Number  *float64 `json:"number,omitempty" mapstructure:"number"`
+	Boolean *bool    `json:"boolean,omitempty" mapstructure:"boolean"`
+	Date    *Date    `json:"date,omitempty" mapstructure:"date"`
+}
+
+// RelationPropertyValue object represents the value of Notion relation property.
+//go:generate gomodifytags --file $GOFILE --struct RelationPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type RelationPropertyValue struct {
+	Type     object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID       string              `json:"id,omitempty" mapstructure:"id"`
+	Relation []PageReference     `json:"relation" mapstructure:"relation"`
+}
+
+// GetType returns the type of the property.
+func (p *RelationPropertyValue) GetType() object.PropertyType {
+	return object.RelationPropertyType
+}
+
+// PageReference object represents a related page.
+//go:generate gomodifytags --file $GOFILE --struct PageReference -add-tags json,mapstructure -w -transform snakecase
+type PageReference struct {
+	ID string `json:"id" mapstructure:"id"`
+}
+
+// RollupPropertyValue object represents the computed value of Notion rollup
+// property. It can't be written.
+//go:generate gomodifytags --file $GOFILE --struct RollupPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type RollupPropertyValue struct {
+	Type   object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID     string              `json:"id,omitempty" mapstructure:"id"`
+	Rollup *RollupValue        `json:"rollup,omitempty" mapstructure:"rollup"`
+}
+
+// GetType returns the type of the property.
+func (p *RollupPropertyValue) GetType() object.PropertyType {
+	return object.RollupPropertyType
+}
+
+// RollupValue object represents the result of a rollup, set according to its
+// type: number, date or array. The items of an array are the values of the
+// rolled up property.
+//go:generate gomodifytags --file $GOFILE --struct RollupValue -add-tags json,mapstructure -w -transform snakecase
+type RollupValue struct {
+	Type     string          `json:"type" mapstructure:"type"`
+	Function string          `json:"function,omitempty" mapstructure:"function"`
+	Number   *float64        `json:"number,omitempty" mapstructure:"number"`
+	Date     *Date           `json:"date,omitempty" mapstructure:"date"`
+	Array    []PropertyValue `json:"array,omitempty" mapstructure:"-"`
+}
+
+// CreatedTimePropertyValue object represents the value of Notion created time property.
+//go:generate gomodifytags --file $GOFILE --struct CreatedTimePropertyValue -add-tags json,mapstructure -w -transform snakecase
+type CreatedTimePropertyValue struct {
+	Type        object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID          string              `json:"id,omitempty" mapstructure:"id"`
+	CreatedTime string              `json:"created_time,omitempty" mapstructure:"created_time"`
+}
+
+// GetType returns the type of the property.
+func (p *CreatedTimePropertyValue) GetType() object.PropertyType {
+	return object.CreatedTimePropertyType
+}
+
+// CreatedByPropertyValue object represents the value of Notion created by property.
+//go:generate gomodifytags --file $GOFILE --struct CreatedByPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type CreatedByPropertyValue struct {
+	Type      object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID        string              `json:"id,omitempty" mapstructure:"id"`
+	CreatedBy *User               `json:"created_by,omitempty" mapstructure:"created_by"`
+}
+
+// GetType returns the type of the property.
+func (p *CreatedByPropertyValue) GetType() object.PropertyType {
+	return object.CreatedByPropertyType
+}
+
+// LastEditedTimePropertyValue object represents the value of Notion last edited time property.
+//go:generate gomodifytags --file $GOFILE --struct LastEditedTimePropertyValue -add-tags json,mapstructure -w -transform snakecase
+type LastEditedTimePropertyValue struct {
+	Type           object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID             string              `json:"id,omitempty" mapstructure:"id"`
+	LastEditedTime string              `json:"last_edited_time,omitempty" mapstructure:"last_edited_time"`
+}
+
+// GetType returns the type of the property.
+func (p *LastEditedTimePropertyValue) GetType() object.PropertyType {
+	return object.LastEditedTimePropertyType
+}
+
+// LastEditedByPropertyValue object represents the value of Notion last edited by property.
+//go:generate gomodifytags --file $GOFILE --struct LastEditedByPropertyValue -add-tags json,mapstructure -w -transform snakecase
+type LastEditedByPropertyValue struct {
+	Type         object.PropertyType `json:"type,omitempty" mapstructure:"type"`
+	ID           string              `json:"id,omitempty" mapstructure:"id"`
+	LastEditedBy *User               `json:"last_edited_by,omitempty" mapstructure:"last_edited_by"`
+}
+
+// GetType returns the type of the property.
+func (p *LastEditedByPropertyValue) GetType() object.PropertyType {
+	return object.LastEditedByPropertyType
+}
+
+// newPropertyValue returns the empty value of the property type.
+func newPropertyValue(typ object.PropertyType) (PropertyValue, error) {
+	switch typ {
+	case object.TitlePropertyType:
+		return &TitlePropertyValue{}, nil
+	case object.TextPropertyType:
+		return &TextPropertyValue{}, nil
+	case object.NumberPropertyType:
+		return &NumberPropertyValue{}, nil
+	case object.SelectPropertyType:
+		return &SelectPropertyValue{}, nil
+	case object.MultiSelectPropertyType:
+		return &MultiSelectPropertyValue{}, nil
+	case object.DatePropertyType:
+		return &DatePropertyValue{}, nil
+	case object.PeoplePropertyType:
+		return &PeoplePropertyValue{}, nil
+	case object.FilesPropertyType:
+		return &FilesPropertyValue{}, nil
+	case object.CheckboxPropertyType:
+		return &CheckboxPropertyValue{}, nil
+	case object.URLPropertyType:
+		return &URLPropertyValue{}, nil
+	case object.EmailPropertyType:
+		return &EmailPropertyValue{}, nil
+	case object.PhoneNumberPropertyType:
+		return &PhoneNumberPropertyValue{}, nil
+	case object.FormulaPropertyType:
+		return &FormulaPropertyValue{}, nil
+	case object.RelationPropertyType:
+		return &RelationPropertyValue{}, nil
+	case object.RollupPropertyType:
+		return &RollupPropertyValue{}, nil
+	case object.CreatedTimePropertyType:
+		return &CreatedTimePropertyValue{}, nil
+	case object.CreatedByPropertyType:
+		return &CreatedByPropertyValue{}, nil
+	case object.LastEditedTimePropertyType:
+		return &LastEditedTimePropertyValue{}, nil
+	case object.LastEditedByPropertyType:
+		return &LastEditedByPropertyValue{}, nil
+	default:
+		return nil, fmt.Errorf("%v type is not supported property type", typ)
+	}
+}
+
+// convPropertyValues decodes the property values of a page.
+func convPropertyValues(input map[string]interface{}) (map[string]PropertyValue, error) {
+	properties := map[string]PropertyValue{}
+	for k, v := range input {
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		p, err := convPropertyValue(obj)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", k, err)
+		}
+
+		properties[k] = p
+	}
+
+	return properties, nil
+}
+
+func convPropertyValue(obj map[string]interface{}) (PropertyValue, error) {
+	typ, _ := obj["type"].(string)
+	p, err := newPropertyValue(object.PropertyType(typ))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := mapstructure.Decode(obj, p); err != nil {
+		return nil, err
+	}
+
+	// The items of a rollup array are property values themselves.
+	if r, ok := p.(*RollupPropertyValue); ok && r.Rollup != nil {
+		rollup, _ := obj["rollup"].(map[string]interface{})
+		items, _ := rollup["array"].([]interface{})
+		for _, item := range items {
+			itemObj, ok := item.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			value, err := convPropertyValue(itemObj)
+			if err != nil {
+				return nil, err
+			}
+			r.Rollup.Array = append(r.Rollup.Array, value)
+		}
+	}
+
+	return p, nil
+}
@@ -0,0 +1,89 @@
+package notion
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+func TestConvPropertyValues(t *testing.T) {
+	input := `{
+		"Stock": {"id": "a", "type": "number", "number": 0},
+		"Visited": {"id": "b", "type": "checkbox", "checkbox": false},
+		"Website": {"id": "c", "type": "url", "url": null},
+		"Email": {"id": "d", "type": "email", "email": "ada@example.com"},
+		"Status": {"id": "e", "type": "select", "select": {"id": "96eb622f", "name": "Done", "color": "green"}},
+		"Owner": {"id": "f", "type": "people", "people": [{"object": "user", "id": "d40e767c", "type": "person", "name": "Ada", "person": {"email": "ada@example.com"}}]},
+		"Meals": {"id": "g", "type": "relation", "relation": [{"id": "dd456007"}]},
+		"Photo": {"id": "h", "type": "files", "files": [{"name": "avocado.png", "type": "external", "external": {"url": "https://example.com/avocado.png"}}]},
+		"Late": {"id": "i", "type": "formula", "formula": {"type": "boolean", "boolean": true}},
+		"Meal names": {"id": "j", "type": "rollup", "rollup": {"type": "array", "function": "show_original", "array": [
+			{"type": "title", "title": [{"type": "text", "text": {"content": "Lunch"}, "plain_text": "Lunch"}]}
+		]}},
+		"Created": {"id": "k", "type": "created_time", "created_time": "2021-05-10T12:00:00.000Z"}
+	}`
+
+	var data map[string]interface{}
+	if err := json.Unmarshal([]byte(input), &data); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	got, err := convPropertyValues(data)
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	zero, yes, email := 0.0, true, "ada@example.com"
+	want := map[string]PropertyValue{
+		"Stock":   &NumberPropertyValue{Type: object.NumberPropertyType, ID: "a", Number: &zero},
+		"Visited": &CheckboxPropertyValue{Type: object.CheckboxPropertyType, ID: "b", Checkbox: false},
+		"Website": &URLPropertyValue{Type: object.URLPropertyType, ID: "c"},
+		"Email":   &EmailPropertyValue{Type: object.EmailPropertyType, ID: "d", Email: &email},
+		"Status": &SelectPropertyValue{
+			Type:   object.SelectPropertyType,
+			ID:     "e",
+			Select: &SelectOption{ID: "96eb622f", Name: "Done", Color: GreenColor},
+		},
+		"Owner": &PeoplePropertyValue{
+			Type:   object.PeoplePropertyType,
+			ID:     "f",
+			People: []*User{{ID: "d40e767c", Type: "person", Name: "Ada", Person: &People{Email: "ada@example.com"}}},
+		},
+		"Meals": &RelationPropertyValue{Type: object.RelationPropertyType, ID: "g", Relation: []PageReference{{ID: "dd456007"}}},
+		"Photo": &FilesPropertyValue{
+			Type:  object.FilesPropertyType,
+			ID:    "h",
+			Files: []File{{Name: "avocado.png", Type: "external", External: &FileLink{URL: "https://example.com/avocado.png"}}},
+		},
+		"Late": &FormulaPropertyValue{Type: object.FormulaPropertyType, ID: "i", Formula: &FormulaValue{Type: "boolean", Boolean: &yes}},
+		"Meal names": &RollupPropertyValue{
+			Type: object.RollupPropertyType,
+			ID:   "j",
+			Rollup: &RollupValue{
+				Type:     "array",
+				Function: "show_original",
+				Array: []PropertyValue{
+					&TitlePropertyValue{Type: object.TitlePropertyType, Title: []TextObject{
+						{Type: TextRichTextType, Text: &Text{Content: "Lunch"}, PlainText: "Lunch"},
+					}},
+				},
+			},
+		},
+		"Created": &CreatedTimePropertyValue{Type: object.CreatedTimePropertyType, ID: "k", CreatedTime: "2021-05-10T12:00:00.000Z"},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestConvPropertyValues_unknownType(t *testing.T) {
+	_, err := convPropertyValues(map[string]interface{}{
+		"Unknown": map[string]interface{}{"id": "a", "type": "button"},
+	})
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
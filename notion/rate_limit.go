@@ -0,0 +1,218 @@
+package notion
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRequestsPerSecond is the average rate allowed by Notion for an integration.
+	defaultRequestsPerSecond = 3
+	defaultBurst             = 3
+
+	defaultRateLimitLimit = 10000
+)
+
+func newDefaultRateLimit() *RateLimit {
+	return &RateLimit{
+		Limit:     defaultRateLimitLimit,
+		Remaining: defaultRateLimitLimit,
+	}
+}
+
+// WithRateLimitHook registers a function called with the new rate limit
+// whenever the rate limit headers returned by the API change.
+func WithRateLimitHook(hook func(RateLimit)) ClientOption {
+	return func(c *Client) {
+		c.rateLimitHook = hook
+	}
+}
+
+// RateLimitSnapshot returns a copy of the latest rate limit reported by the API.
+func (c *Client) RateLimitSnapshot() RateLimit {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	return *c.RateLimit
+}
+
+// updateRateLimit records the rate limit headers of a response.
+func (c *Client) updateRateLimit(h http.Header) error {
+	if !hasRateLimitHeaders(h) {
+		return nil
+	}
+
+	limit, err := parseRateLimitHeader(h, rateLimitLimitHeader)
+	if err != nil {
+		return err
+	}
+
+	remaining, err := parseRateLimitHeader(h, rateLimitRemainingHeader)
+	if err != nil {
+		return err
+	}
+
+	reset, err := parseRateLimitHeader(h, rateLimitResetHeader)
+	if err != nil {
+		return err
+	}
+
+	c.mu.Lock()
+	prev := *c.RateLimit
+	if limit != nil {
+		c.RateLimit.Limit = *limit
+	}
+	if remaining != nil {
+		c.RateLimit.Remaining = *remaining
+	}
+	if reset != nil {
+		c.RateLimit.Reset = time.Unix(int64(*reset), 0)
+	}
+	rl := *c.RateLimit
+	c.mu.Unlock()
+
+	if c.limiter != nil {
+		c.limiter.Update(rl)
+	}
+
+	changed := prev.Limit != rl.Limit || prev.Remaining != rl.Remaining || !prev.Reset.Equal(rl.Reset)
+	if changed && c.rateLimitHook != nil {
+		c.rateLimitHook(rl)
+	}
+
+	return nil
+}
+
+func parseRateLimitHeader(h http.Header, key string) (*int, error) {
+	v := h.Get(key)
+	if v == "" {
+		return nil, nil
+	}
+
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return &i, nil
+}
+
+func hasRateLimitHeaders(h http.Header) bool {
+	return h.Get(rateLimitLimitHeader) != "" || h.Get(rateLimitRemainingHeader) != "" || h.Get(rateLimitResetHeader) != ""
+}
+
+// Limiter paces the requests sent to the Notion API.
+//
+// Implementations must be safe for concurrent use. Passing the same Limiter to
+// several clients makes them share a single request budget.
+type Limiter interface {
+	// Wait blocks until a request may be sent or the context is done.
+	Wait(ctx context.Context) error
+	// Update reports the rate limit advertised by the latest response.
+	Update(rl RateLimit)
+}
+
+// WithLimiter overrides the default client side rate limiter.
+// Passing nil disables client side rate limiting.
+func WithLimiter(limiter Limiter) ClientOption {
+	return func(c *Client) {
+		c.limiter = limiter
+	}
+}
+
+// TokenBucketLimiter is a token bucket Limiter which additionally holds every
+// request back until the reset time once the API reports no remaining requests.
+type TokenBucketLimiter struct {
+	mu           sync.Mutex
+	rate         float64
+	burst        float64
+	tokens       float64
+	last         time.Time
+	blockedUntil time.Time
+}
+
+// NewLimiter returns a TokenBucketLimiter allowing rate requests per second on
+// average with bursts of up to burst requests. A zero rate only honours the
+// limits reported by the API.
+func NewLimiter(rate float64, burst int) *TokenBucketLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &TokenBucketLimiter{
+		rate:   rate,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// Wait implements the Limiter interface.
+func (l *TokenBucketLimiter) Wait(ctx context.Context) error {
+	wait := l.reserve(time.Now())
+	if wait <= 0 {
+		return nil
+	}
+
+	if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < wait {
+		l.cancel()
+		return context.DeadlineExceeded
+	}
+
+	if err := sleep(ctx, wait); err != nil {
+		l.cancel()
+		return err
+	}
+
+	return nil
+}
+
+// Update implements the Limiter interface.
+func (l *TokenBucketLimiter) Update(rl RateLimit) {
+	if rl.Remaining > 0 || rl.Reset.IsZero() {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if rl.Reset.After(l.blockedUntil) {
+		l.blockedUntil = rl.Reset
+	}
+}
+
+// reserve takes a token and returns how long the caller has to wait before using it.
+func (l *TokenBucketLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if l.rate > 0 {
+		elapsed := now.Sub(l.last).Seconds()
+		l.tokens = math.Min(l.burst, l.tokens+elapsed*l.rate)
+	}
+	l.last = now
+	l.tokens--
+
+	var wait time.Duration
+	if l.tokens < 0 && l.rate > 0 {
+		wait = time.Duration(-l.tokens / l.rate * float64(time.Second))
+	}
+
+	if blocked := l.blockedUntil.Sub(now); blocked > wait {
+		wait = blocked
+	}
+
+	return wait
+}
+
+// cancel gives back a token which has been reserved but not used.
+func (l *TokenBucketLimiter) cancel() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.tokens = math.Min(l.burst, l.tokens+1)
+}
@@ -0,0 +1,210 @@
+package notion
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recordingLimiter struct {
+	mu      sync.Mutex
+	waits   int
+	updates []RateLimit
+}
+
+func (l *recordingLimiter) Wait(ctx context.Context) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.waits++
+	return nil
+}
+
+func (l *recordingLimiter) Update(rl RateLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.updates = append(l.updates, rl)
+}
+
+func TestTokenBucketLimiter_Wait(t *testing.T) {
+	tcs := map[string]struct {
+		rate       float64
+		burst      int
+		goroutines int
+		min        time.Duration
+	}{
+		"within burst": {
+			rate:       1,
+			burst:      5,
+			goroutines: 5,
+			min:        0,
+		},
+		"beyond burst": {
+			rate:       100,
+			burst:      1,
+			goroutines: 6,
+			min:        40 * time.Millisecond,
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			l := NewLimiter(tc.rate, tc.burst)
+			start := time.Now()
+
+			var wg sync.WaitGroup
+			for i := 0; i < tc.goroutines; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if err := l.Wait(context.Background()); err != nil {
+						t.Errorf("failed: %v", err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			if elapsed := time.Since(start); elapsed < tc.min {
+				t.Fatalf("limiter did not wait got:%v want:>=%v", elapsed, tc.min)
+			}
+		})
+	}
+}
+
+func TestTokenBucketLimiter_Update(t *testing.T) {
+	l := NewLimiter(0, 1)
+	l.Update(RateLimit{Limit: 10, Remaining: 0, Reset: time.Now().Add(50 * time.Millisecond)})
+
+	start := time.Now()
+	if err := l.Wait(context.Background()); err != nil {
+		t.Fatalf("failed: %v", err)
+	}
+
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Fatalf("limiter did not wait until reset got:%v", elapsed)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	l.Update(RateLimit{Limit: 10, Remaining: 0, Reset: time.Now().Add(time.Minute)})
+	if err := l.Wait(ctx); err == nil {
+		t.Fatalf("expected error when the reset is beyond the context deadline")
+	}
+}
+
+func TestWithLimiter(t *testing.T) {
+	limiter := &recordingLimiter{}
+
+	_, mux, serverURL, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc("/"+usersPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(rateLimitLimitHeader, "10")
+		w.Header().Set(rateLimitRemainingHeader, "0")
+		w.Header().Set(rateLimitResetHeader, "1598795193")
+		fmt.Fprint(w, "{}")
+	})
+
+	// Clients sharing a limiter share their budget.
+	for i := 0; i < 2; i++ {
+		c := NewClient(testAccessKey, WithLimiter(limiter))
+		c.BaseURL, _ = c.BaseURL.Parse(serverURL + baseURLPath)
+
+		if _, err := c.Users.List(context.Background(), nil); err != nil {
+			t.Fatalf("failed: %v", err)
+		}
+	}
+
+	limiter.mu.Lock()
+	defer limiter.mu.Unlock()
+
+	if limiter.waits != 2 {
+		t.Fatalf("waits got:%d want:2", limiter.waits)
+	}
+
+	if len(limiter.updates) != 2 {
+		t.Fatalf("updates got:%d want:2", len(limiter.updates))
+	}
+
+	if got := limiter.updates[0]; got.Limit != 10 || got.Remaining != 0 || got.Reset.Unix() != 1598795193 {
+		t.Fatalf("unexpected rate limit: %+v", got)
+	}
+}
+
+func TestClient_RateLimitSnapshot(t *testing.T) {
+	tcs := map[string]struct {
+		remaining string
+		want      int
+	}{
+		"workspace a": {"42", 42},
+		"workspace b": {"7", 7},
+	}
+
+	clients := map[string]*Client{}
+	for n, tc := range tcs {
+		client, mux, _, teardown := setup()
+		defer teardown()
+
+		remaining := tc.remaining
+		mux.HandleFunc("/"+usersPath, func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set(rateLimitRemainingHeader, remaining)
+			fmt.Fprint(w, "{}")
+		})
+
+		if _, err := client.Users.List(context.Background(), nil); err != nil {
+			t.Fatalf("failed: %v", err)
+		}
+		clients[n] = client
+	}
+
+	for n, tc := range tcs {
+		if got := clients[n].RateLimitSnapshot().Remaining; got != tc.want {
+			t.Fatalf("%s: remaining got:%d want:%d", n, got, tc.want)
+		}
+	}
+}
+
+func TestWithRateLimitHook(t *testing.T) {
+	var (
+		mu    sync.Mutex
+		calls []RateLimit
+	)
+	client, mux, _, teardown := setup(WithRateLimitHook(func(rl RateLimit) {
+		mu.Lock()
+		defer mu.Unlock()
+		calls = append(calls, rl)
+	}))
+	defer teardown()
+
+	remaining := []string{"9", "9", "8"}
+	i := 0
+	mux.HandleFunc("/"+usersPath, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(rateLimitLimitHeader, "10")
+		w.Header().Set(rateLimitRemainingHeader, remaining[i])
+		i++
+		fmt.Fprint(w, "{}")
+	})
+
+	for range remaining {
+		if _, err := client.Users.List(context.Background(), nil); err != nil {
+			t.Fatalf("failed: %v", err)
+		}
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(calls) != 2 {
+		t.Fatalf("hook calls got:%d want:2", len(calls))
+	}
+
+	if calls[1].Remaining != 8 {
+		t.Fatalf("remaining got:%d want:8", calls[1].Remaining)
+	}
+}
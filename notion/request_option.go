@@ -0,0 +1,77 @@
+package notion
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"time"
+)
+
+const idempotencyKeyHeader = "Idempotency-Key"
+
+// RequestOption customizes a single API call.
+type RequestOption func(o *requestOptions)
+
+type requestOptions struct {
+	version string
+	header  http.Header
+	timeout time.Duration
+}
+
+func newRequestOptions(opts []RequestOption) *requestOptions {
+	o := &requestOptions{
+		header: http.Header{},
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// WithCallVersion overrides the Notion API version of the call.
+func WithCallVersion(version string) RequestOption {
+	return func(o *requestOptions) {
+		o.version = version
+	}
+}
+
+// WithHeader sets an additional header on the call.
+func WithHeader(key, value string) RequestOption {
+	return func(o *requestOptions) {
+		o.header.Set(key, value)
+	}
+}
+
+// WithIdempotencyKey sets the Idempotency-Key header of the call. The same
+// key is sent again when the call is retried.
+func WithIdempotencyKey(key string) RequestOption {
+	return WithHeader(idempotencyKeyHeader, key)
+}
+
+// WithTimeout bounds the duration of the call, retries included.
+func WithTimeout(timeout time.Duration) RequestOption {
+	return func(o *requestOptions) {
+		o.timeout = timeout
+	}
+}
+
+// withTimeout returns the context of a call. The returned function releases
+// the context once the response has been handled.
+func (o *requestOptions) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	if o.timeout <= 0 {
+		return ctx, func() {}
+	}
+	return context.WithTimeout(ctx, o.timeout)
+}
+
+// cancelOnClose releases the context of the call once the body has been read,
+// since cancelling it earlier would abort the read.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (b *cancelOnClose) Close() error {
+	defer b.cancel()
+	return b.ReadCloser.Close()
+}
@@ -0,0 +1,90 @@
+package notion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestRequestOption(t *testing.T) {
+	tcs := map[string]struct {
+		opts        []RequestOption
+		wantVersion string
+		wantHeader  map[string]string
+	}{
+		"default": {
+			nil,
+			defaultVersion,
+			map[string]string{idempotencyKeyHeader: ""},
+		},
+		"call version": {
+			[]RequestOption{WithCallVersion("2022-02-22")},
+			"2022-02-22",
+			nil,
+		},
+		"headers": {
+			[]RequestOption{WithHeader("X-Audit", "nightly"), WithIdempotencyKey("b3a6c5")},
+			defaultVersion,
+			map[string]string{"X-Audit": "nightly", idempotencyKeyHeader: "b3a6c5"},
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			client, mux, _, teardown := setup()
+			defer teardown()
+
+			mux.HandleFunc(fmt.Sprintf("/%s/%s", usersPath, "me"), func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get(notionVersionHeader); got != tc.wantVersion {
+					t.Fatalf("version got:%s want:%s", got, tc.wantVersion)
+				}
+
+				for k, v := range tc.wantHeader {
+					if got := r.Header.Get(k); got != v {
+						t.Fatalf("header %s got:%s want:%s", k, got, v)
+					}
+				}
+
+				fmt.Fprint(w, getUserJSON("me"))
+			})
+
+			if _, err := client.Users.Get(context.Background(), "me", tc.opts...); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+		})
+	}
+}
+
+func TestWithTimeout(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", usersPath, "slow"), func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+		fmt.Fprint(w, getUserJSON("slow"))
+	})
+
+	mux.HandleFunc(fmt.Sprintf("/%s/%s", usersPath, "fast"), func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, getUserJSON("fast"))
+	})
+
+	_, err := client.Users.Get(context.Background(), "slow", WithTimeout(20*time.Millisecond))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded got:%v", err)
+	}
+
+	got, err := client.Users.Get(context.Background(), "fast", WithTimeout(time.Second))
+	if err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if got.ID != "fast" {
+		t.Fatalf("body has not been read got:%+v", got)
+	}
+}
@@ -0,0 +1,233 @@
+package notion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+func testRetryPolicy() *RetryPolicy {
+	return &RetryPolicy{
+		MaxRetries: 3,
+		MinBackoff: time.Millisecond,
+		MaxBackoff: 5 * time.Millisecond,
+	}
+}
+
+func TestWithRetryPolicy(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	tcs := map[string]struct {
+		policy       *RetryPolicy
+		failures     int
+		status       int
+		wantAttempts int
+		shouldPass   bool
+	}{
+		"rate limited": {
+			testRetryPolicy(),
+			2,
+			http.StatusTooManyRequests,
+			3,
+			true,
+		},
+		"service unavailable": {
+			testRetryPolicy(),
+			1,
+			http.StatusServiceUnavailable,
+			2,
+			true,
+		},
+		"too many failures": {
+			testRetryPolicy(),
+			5,
+			http.StatusBadGateway,
+			4,
+			false,
+		},
+		"not retryable": {
+			testRetryPolicy(),
+			1,
+			http.StatusBadRequest,
+			1,
+			false,
+		},
+		"no policy": {
+			nil,
+			1,
+			http.StatusTooManyRequests,
+			1,
+			false,
+		},
+	}
+
+	for n, tc := range tcs {
+		t.Run(n, func(t *testing.T) {
+			var (
+				mu       sync.Mutex
+				attempts int
+				bodies   []string
+			)
+
+			path := fmt.Sprintf("/%s/%s/query", databasesPath, n)
+			mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
+				b, _ := io.ReadAll(r.Body)
+
+				mu.Lock()
+				attempts++
+				current := attempts
+				bodies = append(bodies, string(b))
+				mu.Unlock()
+
+				if current <= tc.failures {
+					w.Header().Set(retryAfterHeader, "0")
+					w.WriteHeader(tc.status)
+					fmt.Fprint(w, getErrorJSON(tc.status))
+					return
+				}
+
+				fmt.Fprint(w, `{"object": "list", "results": []}`)
+			})
+
+			client.retryPolicy = tc.policy
+			_, err := client.Databases.Query(context.Background(), n, &DatabaseQuery{PageSize: 10})
+			if tc.shouldPass && err != nil {
+				t.Fatalf("failed: %v", err)
+			}
+
+			if !tc.shouldPass && err == nil {
+				t.Fatalf("expected error")
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			if attempts != tc.wantAttempts {
+				t.Fatalf("attempts got:%d want:%d", attempts, tc.wantAttempts)
+			}
+
+			for _, b := range bodies {
+				if b != bodies[0] {
+					t.Fatalf("body changed between attempts got:%s want:%s", b, bodies[0])
+				}
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_ContextDeadline(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var (
+		mu       sync.Mutex
+		attempts int
+	)
+	mux.HandleFunc("/"+usersPath, func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		attempts++
+		mu.Unlock()
+
+		w.Header().Set(retryAfterHeader, "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+		fmt.Fprint(w, getErrorJSON(http.StatusTooManyRequests))
+	})
+
+	client.retryPolicy = testRetryPolicy()
+	client.retryPolicy.MaxBackoff = 2 * time.Minute
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+
+	start := time.Now()
+	_, err := client.Users.List(ctx, nil)
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+
+	var apiErr *Error
+	if !errors.As(err, &apiErr) {
+		t.Fatalf("expected API error got:%v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if attempts != 1 {
+		t.Fatalf("attempts got:%d want:1", attempts)
+	}
+
+	if time.Since(start) > 500*time.Millisecond {
+		t.Fatalf("waited for retry beyond the context deadline")
+	}
+}
+
+func TestRetryPolicy_backoffRetryAfter(t *testing.T) {
+	p := testRetryPolicy()
+	err := &Error{HTTPStatus: http.StatusTooManyRequests, Code: object.ErrRateLimited}
+
+	tcs := map[string]struct {
+		retryAfter string
+		want       time.Duration
+	}{
+		"below max": {"0", 0},
+		"capped":    {"3600", p.MaxBackoff},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			resp := &http.Response{Header: http.Header{}}
+			resp.Header.Set(retryAfterHeader, tc.retryAfter)
+
+			got, ok := p.backoff(context.Background(), 0, resp, err)
+			if !ok {
+				t.Fatalf("expected retry")
+			}
+			if got != tc.want {
+				t.Fatalf("backoff got:%v want:%v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRetryPolicy_exponential(t *testing.T) {
+	p := &RetryPolicy{
+		MaxRetries: 10,
+		MinBackoff: 100 * time.Millisecond,
+		MaxBackoff: time.Second,
+	}
+
+	tcs := map[string]struct {
+		attempt int
+		min     time.Duration
+		max     time.Duration
+	}{
+		"first":  {0, 50 * time.Millisecond, 100 * time.Millisecond},
+		"third":  {2, 200 * time.Millisecond, 400 * time.Millisecond},
+		"capped": {8, 500 * time.Millisecond, time.Second},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			for i := 0; i < 100; i++ {
+				got := p.exponential(tc.attempt)
+				if got < tc.min || got > tc.max {
+					t.Fatalf("backoff out of range got:%v want:[%v, %v]", got, tc.min, tc.max)
+				}
+			}
+		})
+	}
+}
@@ -3,6 +3,8 @@ package notion
 import (
 	"context"
 	"encoding/json"
+	"errors"
+	"fmt"
 
 	"github.com/ketion-so/go-notion/notion/object"
 	"github.com/mitchellh/mapstructure"
@@ -52,13 +54,37 @@ const (
 	Descending Direction = "descending"
 )
 
-// Sort object represents Notion User.
-//go:generate gomodifytags -file $GOFILE -struct Sort -clear-tags -w
-//go:generate gomodifytags --file $GOFILE --struct Sort -add-tags json,mapstructure -w -transform snakecase
+// Sort object represents a sort of search or query results, either on a
+// property or on a timestamp.
 type Sort struct {
 	Property  string    `json:"property,omitempty" mapstructure:"property"`
 	Direction Direction `json:"direction" mapstructure:"direction"`
-	Timestamp string    `json:"timestamp" mapstructure:"timestamp"`
+	Timestamp Timestamp `json:"timestamp,omitempty" mapstructure:"timestamp"`
+}
+
+// Validate checks that the sort sets exactly one of Property and Timestamp,
+// and a known direction.
+func (s *Sort) Validate() error {
+	switch {
+	case s.Property == "" && s.Timestamp == "":
+		return errors.New("sort sets neither property nor timestamp")
+	case s.Property != "" && s.Timestamp != "":
+		return fmt.Errorf("sort sets both property %q and timestamp %q", s.Property, s.Timestamp)
+	}
+
+	switch s.Timestamp {
+	case "", CreatedTime, LastEditedTime:
+	default:
+		return fmt.Errorf("unknown sort timestamp %q", s.Timestamp)
+	}
+
+	switch s.Direction {
+	case Ascending, Descending:
+	default:
+		return fmt.Errorf("unknown sort direction %q", s.Direction)
+	}
+
+	return nil
 }
 
 // FiterValue is a type for specifying what to filter
@@ -86,8 +112,8 @@ type Filter struct {
 // Get gets user by user ID.
 //
 // API doc: https://developers.notion.com/reference/get-user
-func (s *SearchService) Search(ctx context.Context, sreq *SearchRequest) (*SearchResults, error) {
-	resp, err := s.client.post(ctx, searchPath, sreq)
+func (s *SearchService) Search(ctx context.Context, sreq *SearchRequest, opts ...RequestOption) (*SearchResults, error) {
+	resp, err := s.client.post(ctx, newOperation("Search.Search", ""), searchPath, sreq, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -140,9 +140,9 @@ func TestSearchService_Search(t *testing.T) {
 							},
 						},
 
-						Properties: map[string]Property{
-							"Name":      &DatabaseTitleProperty{Type: "title", ID: "title", Title: &TextObject{}},
-							"Task Type": &MultiSelectProperty{Type: "multi_select", ID: "vd@l"},
+						Properties: map[string]PropertyConfig{
+							"Name":      &TitlePropertyConfig{Type: "title", ID: "title"},
+							"Task Type": &MultiSelectPropertyConfig{Type: "multi_select", ID: "vd@l", Options: []MultiSelectOption{}},
 						},
 					},
 				},
@@ -169,8 +169,8 @@ func TestSearchService_Search(t *testing.T) {
 							Type:       object.DatabaseParentType,
 							DatabaseID: "e6c6f8ff-c70e-4970-91ba-98f03e0d7fc6",
 						},
-						Properties: map[string]Property{
-							"Name": &PageTitleProperty{
+						Properties: map[string]PropertyValue{
+							"Name": &TitlePropertyValue{
 								ID: "title",
 								Title: []TextObject{
 									{
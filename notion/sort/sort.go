@@ -0,0 +1,38 @@
+// Package sort builds the sorts of database queries.
+//
+// Sorts are applied in order, the later ones breaking the ties of the
+// earlier ones:
+//
+//	results, err := client.Databases.Query(ctx, databaseID, &notion.DatabaseQuery{
+//		Sorts: []notion.Sort{
+//			sort.ByProperty("Due", sort.Ascending),
+//			sort.ByTimestamp(sort.LastEditedTime, sort.Descending),
+//		},
+//	})
+//
+// API doc: https://developers.notion.com/reference/post-database-query-sort
+package sort
+
+import (
+	"github.com/ketion-so/go-notion/notion"
+)
+
+const (
+	Ascending  = notion.Ascending
+	Descending = notion.Descending
+)
+
+const (
+	CreatedTime    = notion.CreatedTime
+	LastEditedTime = notion.LastEditedTime
+)
+
+// ByProperty sorts the pages by the value of the property.
+func ByProperty(property string, direction notion.Direction) notion.Sort {
+	return notion.Sort{Property: property, Direction: direction}
+}
+
+// ByTimestamp sorts the pages by their creation or last edition time.
+func ByTimestamp(timestamp notion.Timestamp, direction notion.Direction) notion.Sort {
+	return notion.Sort{Timestamp: timestamp, Direction: direction}
+}
@@ -0,0 +1,65 @@
+package sort
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/ketion-so/go-notion/notion"
+)
+
+func TestSort(t *testing.T) {
+	tcs := map[string]struct {
+		sort notion.Sort
+		want string
+	}{
+		"property": {
+			ByProperty("Due", Ascending),
+			`{"property":"Due","direction":"ascending"}`,
+		},
+		"timestamp": {
+			ByTimestamp(LastEditedTime, Descending),
+			`{"direction":"descending","timestamp":"last_edited_time"}`,
+		},
+	}
+
+	for n, tc := range tcs {
+		tc := tc
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			if err := tc.sort.Validate(); err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			got, err := json.Marshal(tc.sort)
+			if err != nil {
+				t.Fatalf("Failed: %v", err)
+			}
+
+			if diff := cmp.Diff(string(got), tc.want); diff != "" {
+				t.Fatalf("Diff: %s(-got +want)", diff)
+			}
+		})
+	}
+}
+
+func TestSort_Validate(t *testing.T) {
+	tcs := map[string]notion.Sort{
+		"empty":             {Direction: Ascending},
+		"both":              {Property: "Due", Timestamp: CreatedTime, Direction: Ascending},
+		"unknown timestamp": ByTimestamp("archived_time", Ascending),
+		"no direction":      ByProperty("Due", ""),
+	}
+
+	for n, s := range tcs {
+		s := s
+		t.Run(n, func(t *testing.T) {
+			t.Parallel()
+
+			if err := s.Validate(); err == nil {
+				t.Fatalf("expected error")
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package notion
+
+import (
+	"context"
+)
+
+// QueryStream queries the database in the background and sends the matching
+// pages on the returned channel, so that they can be processed while the next
+// result page is fetched.
+//
+// One result page is prefetched ahead of the consumer, within the rate limit
+// of the client. Both channels are closed once the iteration is over; the error
+// channel receives the error which stopped it, if any, including the context
+// error when ctx is cancelled. The consumer must either drain the page channel
+// or cancel ctx to release the goroutine.
+func (s *DatabasesService) QueryStream(ctx context.Context, databaseID string, query *DatabaseQuery, iopts *IteratorOptions, opts ...RequestOption) (<-chan *Page, <-chan error) {
+	size := maxPageSize
+	if iopts != nil && iopts.PageSize > 0 && iopts.PageSize < maxPageSize {
+		size = int(iopts.PageSize)
+	}
+
+	pages := make(chan *Page, size)
+	errc := make(chan error, 1)
+
+	go func() {
+		defer close(pages)
+		defer close(errc)
+
+		it := s.QueryIterator(databaseID, query, iopts, opts...)
+		for it.Next(ctx) {
+			select {
+			case pages <- it.Value():
+			case <-ctx.Done():
+				errc <- ctx.Err()
+				return
+			}
+		}
+
+		if err := it.Err(); err != nil {
+			errc <- err
+		}
+	}()
+
+	return pages, errc
+}
@@ -0,0 +1,94 @@
+package notion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDatabasesService_QueryStream(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	requests := []string{}
+	mux.HandleFunc(fmt.Sprintf("/%s/db/query", databasesPath), pagedHandler(t, pageItemsJSON(5), &mu, &requests))
+
+	pages, errc := client.Databases.QueryStream(context.Background(), "db", &DatabaseQuery{}, &IteratorOptions{PageSize: 2})
+
+	first := <-pages
+	deadline := time.Now().Add(time.Second)
+	for {
+		mu.Lock()
+		n := len(requests)
+		mu.Unlock()
+
+		if n >= 2 {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("next page has not been prefetched")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	ids := []string{first.ID}
+	for p := range pages {
+		ids = append(ids, p.ID)
+	}
+
+	if err := <-errc; err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	if diff := cmp.Diff(ids, []string{"p0", "p1", "p2", "p3", "p4"}); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestDatabasesService_QueryStream_cancel(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	requests := []string{}
+	mux.HandleFunc(fmt.Sprintf("/%s/db/query", databasesPath), pagedHandler(t, pageItemsJSON(10), &mu, &requests))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	pages, errc := client.Databases.QueryStream(ctx, "db", &DatabaseQuery{}, &IteratorOptions{PageSize: 1})
+
+	<-pages
+	cancel()
+
+	for range pages {
+	}
+
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected canceled got:%v", err)
+	}
+}
+
+func TestDatabasesService_QueryStream_bufferCapped(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	var mu sync.Mutex
+	requests := []string{}
+	mux.HandleFunc(fmt.Sprintf("/%s/db/query", databasesPath), pagedHandler(t, pageItemsJSON(1), &mu, &requests))
+
+	pages, errc := client.Databases.QueryStream(context.Background(), "db", nil, &IteratorOptions{PageSize: 1 << 30})
+	if cap(pages) != maxPageSize {
+		t.Fatalf("buffer size got:%d want:%d", cap(pages), maxPageSize)
+	}
+
+	for range pages {
+	}
+	if err := <-errc; err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+}
@@ -0,0 +1,276 @@
+package notion
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+
+	"github.com/ketion-so/go-notion/notion/object"
+)
+
+// Table reads and writes the pages of a database as rows of a struct type,
+// mapped with the notion tags of UnmarshalPage and MarshalProperties.
+//
+// The row type must have a field tagged `notion:",id"` to be updated or
+// deleted. Rows embedding a RowSnapshot remember the properties they have
+// been read or written with, so that Update only sends the properties changed
+// since. A Table is safe for concurrent use.
+type Table struct {
+	client     *Client
+	databaseID string
+	rowType    reflect.Type
+	idField    *field
+	snapshot   []int
+	err        error
+}
+
+// RowSnapshot holds the properties of a row as last read or written by a
+// Table. Embed it in a row type for Table.Update to only send the changed
+// properties:
+//
+//	type Grocery struct {
+//		notion.RowSnapshot
+//		ID   string `notion:",id"`
+//		Name string `notion:"Name,title"`
+//	}
+type RowSnapshot struct {
+	properties map[string][]byte
+}
+
+var rowSnapshotType = reflect.TypeOf(RowSnapshot{})
+
+// NewTable returns the table of the database with rows of the type of row,
+// e.g. MyRow{} or &MyRow{}. An invalid row type is reported by the methods
+// of the table.
+func NewTable(client *Client, databaseID string, row interface{}) *Table {
+	t := &Table{
+		client:     client,
+		databaseID: databaseID,
+	}
+
+	rt := reflect.TypeOf(row)
+	if rt != nil && rt.Kind() == reflect.Ptr {
+		rt = rt.Elem()
+	}
+	if rt == nil || rt.Kind() != reflect.Struct {
+		t.err = fmt.Errorf("row must be a struct, got %T", row)
+		return t
+	}
+	t.rowType = rt
+
+	fields, err := structFields(rt)
+	if err != nil {
+		t.err = err
+		return t
+	}
+	for _, f := range fields {
+		if f.isMeta() && f.typ == "id" {
+			t.idField = f
+		}
+	}
+
+	if sf, ok := rt.FieldByName(rowSnapshotType.Name()); ok && sf.Anonymous && sf.Type == rowSnapshotType {
+		t.snapshot = sf.Index
+	}
+
+	return t
+}
+
+// Find queries the pages matching the filter, which may be nil, and appends
+// them to the slice pointed to by dst, e.g. a *[]MyRow or a *[]*MyRow.
+func (t *Table) Find(ctx context.Context, filter FilterObject, dst interface{}, opts ...RequestOption) error {
+	if t.err != nil {
+		return t.err
+	}
+
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Ptr || dv.IsNil() || dv.Elem().Kind() != reflect.Slice {
+		return fmt.Errorf("find destination must be a pointer to a slice, got %T", dst)
+	}
+	slice := dv.Elem()
+
+	elemType := slice.Type().Elem()
+	if elemType != t.rowType && elemType != reflect.PtrTo(t.rowType) {
+		return fmt.Errorf("find destination must be a slice of %s, got %T", t.rowType, dst)
+	}
+
+	it := t.client.Databases.QueryIterator(t.databaseID, &DatabaseQuery{Filter: filter}, nil, opts...)
+	for it.Next(ctx) {
+		row := reflect.New(t.rowType)
+		if err := t.load(it.Value(), row.Interface()); err != nil {
+			return err
+		}
+
+		if elemType.Kind() == reflect.Ptr {
+			slice = reflect.Append(slice, row)
+		} else {
+			slice = reflect.Append(slice, row.Elem())
+		}
+	}
+	dv.Elem().Set(slice)
+
+	return it.Err()
+}
+
+// Insert creates a page in the database from the row, a pointer to the row
+// type, which is then updated from the created page, e.g. with its ID.
+func (t *Table) Insert(ctx context.Context, row interface{}, opts ...RequestOption) error {
+	if err := t.checkRow(row); err != nil {
+		return err
+	}
+
+	properties, err := MarshalProperties(row)
+	if err != nil {
+		return err
+	}
+
+	page, err := t.client.Pages.Create(ctx, &CreatePageRequest{
+		Parent:     &DatabaseParent{Type: object.DatabaseParentType, DatabaseID: t.databaseID},
+		Properties: properties,
+	}, opts...)
+	if err != nil {
+		return err
+	}
+
+	return t.load(page, row)
+}
+
+// Update updates the page of the row, a pointer to the row type. When the row
+// embeds a RowSnapshot filled by the table, only the properties changed since
+// the row was read or written are sent, and nothing is sent when none has
+// changed. All the properties are sent otherwise.
+func (t *Table) Update(ctx context.Context, row interface{}, opts ...RequestOption) error {
+	id, err := t.rowID(row)
+	if err != nil {
+		return err
+	}
+
+	properties, err := MarshalProperties(row)
+	if err != nil {
+		return err
+	}
+
+	changed := properties
+	if snapshot := t.rowSnapshot(row); snapshot != nil && snapshot.properties != nil {
+		encoded, err := encodeProperties(properties)
+		if err != nil {
+			return err
+		}
+
+		changed = map[string]PropertyValue{}
+		for name, b := range encoded {
+			if old, ok := snapshot.properties[name]; !ok || !bytes.Equal(old, b) {
+				changed[name] = properties[name]
+			}
+		}
+		if len(changed) == 0 {
+			return nil
+		}
+	}
+
+	page, err := t.client.Pages.UpdateProperties(ctx, id, &UpdatePageRequest{Properties: changed}, opts...)
+	if err != nil {
+		return err
+	}
+
+	return t.load(page, row)
+}
+
+// Delete archives the page of the row, a pointer to the row type.
+func (t *Table) Delete(ctx context.Context, row interface{}, opts ...RequestOption) error {
+	id, err := t.rowID(row)
+	if err != nil {
+		return err
+	}
+
+	archived := true
+	if _, err := t.client.Pages.UpdateProperties(ctx, id, &UpdatePageRequest{Archived: &archived}, opts...); err != nil {
+		return err
+	}
+
+	if snapshot := t.rowSnapshot(row); snapshot != nil {
+		snapshot.properties = nil
+	}
+
+	return nil
+}
+
+// load decodes the page into the row and records its properties in the
+// snapshot of the row, if any.
+func (t *Table) load(page *Page, row interface{}) error {
+	if err := UnmarshalPage(page, row); err != nil {
+		return fmt.Errorf("page %s: %w", page.ID, err)
+	}
+
+	snapshot := t.rowSnapshot(row)
+	if snapshot == nil {
+		return nil
+	}
+
+	properties, err := MarshalProperties(row)
+	if err != nil {
+		return err
+	}
+
+	encoded, err := encodeProperties(properties)
+	if err != nil {
+		return err
+	}
+	snapshot.properties = encoded
+
+	return nil
+}
+
+// rowSnapshot returns the snapshot embedded in the row, a pointer to the row
+// type, or nil when the row type doesn't embed one.
+func (t *Table) rowSnapshot(row interface{}) *RowSnapshot {
+	if t.snapshot == nil {
+		return nil
+	}
+	return reflect.ValueOf(row).Elem().FieldByIndex(t.snapshot).Addr().Interface().(*RowSnapshot)
+}
+
+func (t *Table) checkRow(row interface{}) error {
+	if t.err != nil {
+		return t.err
+	}
+
+	if reflect.TypeOf(row) != reflect.PtrTo(t.rowType) || reflect.ValueOf(row).IsNil() {
+		return fmt.Errorf("row must be a non-nil *%s, got %T", t.rowType, row)
+	}
+
+	return nil
+}
+
+func (t *Table) rowID(row interface{}) (string, error) {
+	if err := t.checkRow(row); err != nil {
+		return "", err
+	}
+
+	if t.idField == nil {
+		return "", fmt.Errorf("%s has no field tagged `notion:\",id\"`", t.rowType)
+	}
+
+	id := reflect.ValueOf(row).Elem().FieldByIndex(t.idField.index)
+	if id.Kind() != reflect.String || id.String() == "" {
+		return "", errors.New("row has no page ID")
+	}
+
+	return id.String(), nil
+}
+
+func encodeProperties(properties map[string]PropertyValue) (map[string][]byte, error) {
+	encoded := map[string][]byte{}
+	for name, p := range properties {
+		b, err := json.Marshal(p)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		encoded[name] = b
+	}
+
+	return encoded, nil
+}
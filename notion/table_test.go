@@ -0,0 +1,220 @@
+package notion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+type tableRow struct {
+	RowSnapshot
+	ID    string  `notion:",id"`
+	Name  string  `notion:"Name,title"`
+	Price float64 `notion:"Price,number"`
+	Done  bool    `notion:"Done,checkbox"`
+}
+
+func tablePageJSON(row tableRow) string {
+	return fmt.Sprintf(`{
+		"object": "page",
+		"id": %q,
+		"parent": {"type": "database_id", "database_id": "db"},
+		"properties": {
+			"Name": {"id": "title", "type": "title", "title": [{"type": "text", "text": {"content": %q}, "plain_text": %q}]},
+			"Price": {"id": "cU^N", "type": "number", "number": %v},
+			"Done": {"id": "{xY", "type": "checkbox", "checkbox": %t}
+		}
+	}`, row.ID, row.Name, row.Name, row.Price, row.Done)
+}
+
+// tableHandler serves the rows of a database and applies the page updates to
+// them, recording the request bodies.
+func tableHandler(t *testing.T, mux *http.ServeMux, rows map[string]*tableRow) (*sync.Mutex, *[]string) {
+	var mu sync.Mutex
+	bodies := []string{}
+
+	mux.HandleFunc(fmt.Sprintf("/%s/db/query", databasesPath), func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		fmt.Fprintf(w, `{"object": "list", "results": [%s, %s], "has_more": false}`, tablePageJSON(*rows["p1"]), tablePageJSON(*rows["p2"]))
+	})
+
+	handlePage := func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		var body struct {
+			Properties struct {
+				Price *NumberPropertyValue `json:"Price"`
+				Name  *TitlePropertyValue  `json:"Name"`
+			} `json:"properties"`
+		}
+		raw := map[string]interface{}{}
+		if err := json.NewDecoder(r.Body).Decode(&raw); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+		b, _ := json.Marshal(raw)
+		bodies = append(bodies, r.Method+" "+string(b))
+		if err := json.Unmarshal(b, &body); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+
+		id := "p3"
+		if r.Method == http.MethodPost {
+			rows[id] = &tableRow{ID: id}
+		} else {
+			id = r.URL.Path[len("/"+pagesPath+"/"):]
+		}
+
+		row := rows[id]
+		if p := body.Properties.Price; p != nil && p.Number != nil {
+			row.Price = *p.Number
+		}
+		if p := body.Properties.Name; p != nil {
+			row.Name = plainText(p.Title)
+		}
+
+		fmt.Fprint(w, tablePageJSON(*row))
+	}
+	mux.HandleFunc("/"+pagesPath, handlePage)
+	mux.HandleFunc("/"+pagesPath+"/", handlePage)
+
+	return &mu, &bodies
+}
+
+func TestTable(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mu, bodies := tableHandler(t, mux, map[string]*tableRow{
+		"p1": {ID: "p1", Name: "Avocado", Price: 2.5},
+		"p2": {ID: "p2", Name: "Apple", Price: 1, Done: true},
+	})
+
+	table := NewTable(client, "db", tableRow{})
+
+	rows := []*tableRow{}
+	if err := table.Find(context.Background(), nil, &rows); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := []*tableRow{
+		{ID: "p1", Name: "Avocado", Price: 2.5},
+		{ID: "p2", Name: "Apple", Price: 1, Done: true},
+	}
+	if diff := cmp.Diff(rows, want, cmpopts.IgnoreUnexported(RowSnapshot{})); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	rows[0].Price = 3
+	if err := table.Update(context.Background(), rows[0]); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if err := table.Update(context.Background(), rows[1]); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	inserted := &tableRow{Name: "Banana", Price: 0.5}
+	if err := table.Insert(context.Background(), inserted); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+	if inserted.ID != "p3" {
+		t.Fatalf("inserted row ID got:%s want:p3", inserted.ID)
+	}
+
+	if err := table.Delete(context.Background(), rows[1]); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	wantBodies := []string{
+		`PATCH {"properties":{"Price":{"number":3}}}`,
+		`POST {"parent":{"database_id":"db","type":"database_id"},"properties":{"Done":{"checkbox":false},"Name":{"title":[{"text":{"content":"Banana"},"type":"text"}]},"Price":{"number":0.5}}}`,
+		`PATCH {"archived":true}`,
+	}
+	if diff := cmp.Diff(*bodies, wantBodies); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestTable_noSnapshot(t *testing.T) {
+	client, mux, _, teardown := setup()
+	defer teardown()
+
+	mu, bodies := tableHandler(t, mux, map[string]*tableRow{
+		"p1": {ID: "p1", Name: "Avocado", Price: 2.5},
+		"p2": {ID: "p2", Name: "Apple", Price: 1, Done: true},
+	})
+
+	type row struct {
+		ID    string  `notion:",id"`
+		Name  string  `notion:"Name,title"`
+		Price float64 `notion:"Price,number"`
+		Store string  `notion:"Store,text"`
+	}
+	table := NewTable(client, "db", row{})
+
+	rows := []row{}
+	if err := table.Find(context.Background(), nil, &rows); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	want := []row{{ID: "p1", Name: "Avocado", Price: 2.5}, {ID: "p2", Name: "Apple", Price: 1}}
+	if diff := cmp.Diff(rows, want); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+
+	if err := table.Update(context.Background(), &rows[0]); err != nil {
+		t.Fatalf("Failed: %v", err)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	wantBodies := []string{
+		`PATCH {"properties":{"Name":{"title":[{"text":{"content":"Avocado"},"type":"text"}]},"Price":{"number":2.5},"Store":{"text":[]}}}`,
+	}
+	if diff := cmp.Diff(*bodies, wantBodies); diff != "" {
+		t.Fatalf("Diff: %s(-got +want)", diff)
+	}
+}
+
+func TestTable_errors(t *testing.T) {
+	client, _, _, teardown := setup()
+	defer teardown()
+
+	if err := NewTable(client, "db", "row").Find(context.Background(), nil, &[]tableRow{}); err == nil {
+		t.Fatalf("expected error for invalid row type")
+	}
+
+	table := NewTable(client, "db", &tableRow{})
+	if err := table.Find(context.Background(), nil, &[]string{}); err == nil {
+		t.Fatalf("expected error for invalid destination")
+	}
+
+	if err := table.Update(context.Background(), tableRow{ID: "p1"}); err == nil {
+		t.Fatalf("expected error for row passed by value")
+	}
+
+	if err := table.Delete(context.Background(), &tableRow{}); err == nil {
+		t.Fatalf("expected error for row without ID")
+	}
+
+	noID := NewTable(client, "db", struct {
+		Name string `notion:"Name,title"`
+	}{})
+	if err := noID.Delete(context.Background(), &struct {
+		Name string `notion:"Name,title"`
+	}{}); err == nil {
+		t.Fatalf("expected error for row type without ID field")
+	}
+}
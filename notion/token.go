@@ -0,0 +1,43 @@
+package notion
+
+import (
+	"context"
+)
+
+// TokenSource provides the bearer token authenticating a request.
+//
+// The Client consults its TokenSource before every request, so the token can
+// be rotated or resolved per workspace at runtime. Implementations must be safe
+// for concurrent use.
+type TokenSource interface {
+	Token(ctx context.Context) (string, error)
+}
+
+// TokenSourceFunc is an adapter to use ordinary functions as TokenSource.
+type TokenSourceFunc func(ctx context.Context) (string, error)
+
+// Token implements the TokenSource interface.
+func (f TokenSourceFunc) Token(ctx context.Context) (string, error) {
+	return f(ctx)
+}
+
+type staticTokenSource string
+
+// StaticTokenSource returns a TokenSource always returning the same token,
+// e.g. the secret of an internal integration.
+func StaticTokenSource(token string) TokenSource {
+	return staticTokenSource(token)
+}
+
+// Token implements the TokenSource interface.
+func (s staticTokenSource) Token(ctx context.Context) (string, error) {
+	return string(s), nil
+}
+
+// WithTokenSource authenticates the requests with the tokens provided by ts
+// instead of the access key given to NewClient.
+func WithTokenSource(ts TokenSource) ClientOption {
+	return func(c *Client) {
+		c.tokenSource = ts
+	}
+}
@@ -0,0 +1,52 @@
+package notion
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"testing"
+)
+
+func TestWithTokenSource(t *testing.T) {
+	var (
+		mu     sync.Mutex
+		tokens = []string{"secret_1", "secret_2"}
+		i      int
+	)
+	ts := TokenSourceFunc(func(ctx context.Context) (string, error) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		if i >= len(tokens) {
+			return "", errors.New("token revoked")
+		}
+		token := tokens[i]
+		i++
+		return token, nil
+	})
+
+	client, mux, _, teardown := setup(WithTokenSource(ts))
+	defer teardown()
+
+	var got []string
+	mux.HandleFunc("/"+usersPath, func(w http.ResponseWriter, r *http.Request) {
+		got = append(got, r.Header.Get("Authorization"))
+		fmt.Fprint(w, "{}")
+	})
+
+	for range tokens {
+		if _, err := client.Users.List(context.Background(), nil); err != nil {
+			t.Fatalf("Failed: %v", err)
+		}
+	}
+
+	if _, err := client.Users.List(context.Background(), nil); err == nil {
+		t.Fatalf("expected token source error")
+	}
+
+	if len(got) != 2 || got[0] != "Bearer secret_1" || got[1] != "Bearer secret_2" {
+		t.Fatalf("unexpected authorization headers: %v", got)
+	}
+}
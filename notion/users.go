@@ -47,8 +47,8 @@ type Bot struct{}
 // Get gets user by user ID.
 //
 // API doc: https://developers.notion.com/reference/get-user
-func (s *UsersService) Get(ctx context.Context, userID string) (*User, error) {
-	resp, err := s.client.get(ctx, fmt.Sprintf("%s/%s", usersPath, userID))
+func (s *UsersService) Get(ctx context.Context, userID string, opts ...RequestOption) (*User, error) {
+	resp, err := s.client.get(ctx, newOperation("Users.Get", userID), fmt.Sprintf("%s/%s", usersPath, userID), opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -76,8 +76,8 @@ type ListUserResponse struct {
 // List gets the list of users.
 //
 // API doc: https://developers.notion.com/reference/get-users
-func (s *UsersService) List(ctx context.Context) (*ListUserResponse, error) {
-	resp, err := s.client.get(ctx, usersPath)
+func (s *UsersService) List(ctx context.Context, lopts *ListOptions, opts ...RequestOption) (*ListUserResponse, error) {
+	resp, err := s.client.get(ctx, newOperation("Users.List", ""), addOptions(usersPath, lopts), opts...)
 	if err != nil {
 		return nil, err
 	}
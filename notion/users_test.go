@@ -137,7 +137,7 @@ func TestUsersService_List(t *testing.T) {
 				fmt.Fprint(w, getListUserSON())
 			})
 
-			got, err := client.Users.List(context.Background())
+			got, err := client.Users.List(context.Background(), nil)
 			if err != nil {
 				t.Fatalf("Failed: %v", err)
 			}
@@ -2,5 +2,5 @@ package notion
 
 const (
 	notionVersionHeader = "Notion-Version"
-	defaultVersion      = " 2021-05-13"
+	defaultVersion      = "2021-05-13"
 )